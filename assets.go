@@ -0,0 +1,289 @@
+package main
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/g3n/engine/core"
+	"github.com/g3n/engine/gls"
+	"github.com/g3n/engine/loader/obj"
+	"github.com/g3n/engine/text"
+	"github.com/g3n/engine/texture"
+)
+
+//go:embed assets
+var embeddedAssets embed.FS
+
+// defaultResourcePack is the resource pack directory under the settings directory
+const defaultResourcePack = "resourcepack"
+
+// AssetSettings configures where the assets are loaded from
+type AssetSettings struct {
+	// ResourcePack is the directory which overrides the embedded assets,
+	// a relative path is relative to the settings file
+	ResourcePack string `json:"resource_pack,omitempty"`
+}
+
+// Assets loads the assets from the resource pack and falls back to the embedded assets.
+// The loaded textures, fonts and models are cached by their names.
+type Assets struct {
+	mux    sync.Mutex
+	packs  []assetLayer
+	images map[string]*image.RGBA
+	tex    map[string]*texture.Texture2D
+	fonts  map[string]*text.Font
+	models map[string]*obj.Decoder
+
+	missing     map[string]error
+	placeholder *texture.Texture2D
+}
+
+type assetLayer struct {
+	name string
+	fsys fs.FS
+}
+
+// NewAssets creates the assets which are overridden by the resource pack directory, packDir can be empty
+func NewAssets(packDir string) (a *Assets) {
+	embedded, err := fs.Sub(embeddedAssets, "assets")
+	if err != nil {
+		panic(err)
+	}
+	var layers []assetLayer
+	if packDir != "" {
+		if info, err := os.Stat(packDir); err == nil && info.IsDir() {
+			renderLog.Info("using resource pack", "dir", packDir)
+			layers = append(layers, assetLayer{packDir, os.DirFS(packDir)})
+		} else if !errors.Is(err, fs.ErrNotExist) {
+			renderLog.Warn("cannot use resource pack", "dir", packDir, "err", err)
+		}
+	}
+	layers = append(layers, assetLayer{"embedded", embedded})
+	return newAssets(layers...)
+}
+
+// newAssets creates the assets from the layers, the former layers override the latter ones
+func newAssets(layers ...assetLayer) (a *Assets) {
+	a = new(Assets)
+	a.packs = layers
+	a.images = make(map[string]*image.RGBA)
+	a.tex = make(map[string]*texture.Texture2D)
+	a.fonts = make(map[string]*text.Font)
+	a.models = make(map[string]*obj.Decoder)
+	a.missing = make(map[string]error)
+	return
+}
+
+// Open opens the asset from the first layer which has it, the name is a slash separated path
+func (a *Assets) Open(name string) (fs.File, error) {
+	f, _, err := a.open(name)
+	return f, err
+}
+
+func (a *Assets) open(name string) (f fs.File, source string, err error) {
+	name = path.Clean(strings.TrimPrefix(name, "/"))
+	if !fs.ValidPath(name) {
+		return nil, "", &fs.PathError{Op: "open", Path: name, Err: fs.ErrInvalid}
+	}
+	for _, l := range a.packs {
+		if f, err = l.fsys.Open(name); err == nil {
+			return f, l.name, nil
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			return nil, l.name, err
+		}
+	}
+	return nil, "", &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+}
+
+// Source returns which layer provides the asset, or an empty string if it does not exist
+func (a *Assets) Source(name string) string {
+	f, source, err := a.open(name)
+	if err != nil {
+		return ""
+	}
+	f.Close()
+	return source
+}
+
+// ReadFile reads the whole asset
+func (a *Assets) ReadFile(name string) ([]byte, error) {
+	f, _, err := a.open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return io.ReadAll(f)
+}
+
+// report records the missing or broken asset, it's logged once
+func (a *Assets) report(name string, err error) {
+	if _, ok := a.missing[name]; ok {
+		return
+	}
+	a.missing[name] = err
+	renderLog.Warn("cannot load asset", "name", name, "err", err)
+}
+
+// Missing returns the assets which failed to load, and the reasons
+func (a *Assets) Missing() (names []string, errs []error) {
+	a.mux.Lock()
+	defer a.mux.Unlock()
+	for name := range a.missing {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	errs = make([]error, len(names))
+	for i, name := range names {
+		errs[i] = a.missing[name]
+	}
+	return
+}
+
+// Image decodes the image asset
+func (a *Assets) Image(name string) (*image.RGBA, error) {
+	a.mux.Lock()
+	defer a.mux.Unlock()
+	return a.image(name)
+}
+
+func (a *Assets) image(name string) (*image.RGBA, error) {
+	if img, ok := a.images[name]; ok {
+		return img, nil
+	}
+	f, _, err := a.open(name)
+	if err != nil {
+		a.report(name, err)
+		return nil, err
+	}
+	defer f.Close()
+	src, _, err := image.Decode(f)
+	if err != nil {
+		err = fmt.Errorf("decode %s: %w", name, err)
+		a.report(name, err)
+		return nil, err
+	}
+	rgba, ok := src.(*image.RGBA)
+	if !ok || rgba.Stride != rgba.Rect.Dx()*4 {
+		rgba = image.NewRGBA(src.Bounds())
+		draw.Draw(rgba, rgba.Bounds(), src, src.Bounds().Min, draw.Src)
+	}
+	a.images[name] = rgba
+	return rgba, nil
+}
+
+// Texture returns the texture of the image asset, the caller owns a reference and should dispose it.
+// A placeholder texture is returned if the asset is missing or broken.
+func (a *Assets) Texture(name string) *texture.Texture2D {
+	a.mux.Lock()
+	defer a.mux.Unlock()
+	if tex, ok := a.tex[name]; ok {
+		return tex.Incref()
+	}
+	img, err := a.image(name)
+	if err != nil {
+		return a.placeholderTexture().Incref()
+	}
+	tex := texture.NewTexture2DFromRGBA(img)
+	a.tex[name] = tex
+	return tex.Incref()
+}
+
+// placeholderTexture is a magenta and black checkerboard which makes the missing textures obvious
+func (a *Assets) placeholderTexture() *texture.Texture2D {
+	if a.placeholder == nil {
+		img := image.NewRGBA(image.Rect(0, 0, 2, 2))
+		for i, c := range []byte{255, 0, 0, 255} {
+			x, y := i%2, i/2
+			img.Pix[img.PixOffset(x, y)+0] = c
+			img.Pix[img.PixOffset(x, y)+2] = c
+			img.Pix[img.PixOffset(x, y)+3] = 255
+		}
+		a.placeholder = texture.NewTexture2DFromRGBA(img)
+		a.placeholder.SetMagFilter(gls.NEAREST)
+	}
+	return a.placeholder
+}
+
+// Font returns the font asset, the font is shared so its attributes should not be changed
+func (a *Assets) Font(name string) (*text.Font, error) {
+	a.mux.Lock()
+	defer a.mux.Unlock()
+	if font, ok := a.fonts[name]; ok {
+		return font, nil
+	}
+	data, err := a.readFile(name)
+	if err != nil {
+		return nil, err
+	}
+	font, err := text.NewFontFromData(data)
+	if err != nil {
+		err = fmt.Errorf("parse %s: %w", name, err)
+		a.report(name, err)
+		return nil, err
+	}
+	a.fonts[name] = font
+	return font, nil
+}
+
+func (a *Assets) readFile(name string) ([]byte, error) {
+	data, err := a.ReadFile(name)
+	if err != nil {
+		a.report(name, err)
+	}
+	return data, err
+}
+
+// Model returns a new node of the obj model asset.
+// The materials are read from the mtl asset of the same name if it exists.
+func (a *Assets) Model(name string) (*core.Node, error) {
+	a.mux.Lock()
+	defer a.mux.Unlock()
+	dec, ok := a.models[name]
+	if !ok {
+		objData, err := a.readFile(name)
+		if err != nil {
+			return nil, err
+		}
+		var mtl io.Reader
+		if data, err := a.ReadFile(strings.TrimSuffix(name, path.Ext(name)) + ".mtl"); err == nil {
+			mtl = bytes.NewReader(data)
+		}
+		if dec, err = obj.DecodeReader(bytes.NewReader(objData), mtl); err != nil {
+			err = fmt.Errorf("decode %s: %w", name, err)
+			a.report(name, err)
+			return nil, err
+		}
+		a.models[name] = dec
+	}
+	return dec.NewGroup()
+}
+
+// resourcePackDir resolves the resource pack directory of the settings
+func resourcePackDir(s AssetSettings, settingsPath string) string {
+	dir := s.ResourcePack
+	if dir == "" {
+		dir = defaultResourcePack
+	}
+	if filepath.IsAbs(dir) {
+		return dir
+	}
+	if settingsPath == "" {
+		return ""
+	}
+	return filepath.Join(filepath.Dir(settingsPath), dir)
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+	"io/fs"
+	"testing"
+	"testing/fstest"
+)
+
+func pngData(t *testing.T, c color.Color) []byte {
+	t.Helper()
+	img := image.NewNRGBA(image.Rect(0, 0, 2, 2))
+	for i := 0; i < 4; i++ {
+		img.Set(i%2, i/2, c)
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestEmbeddedAssets(t *testing.T) {
+	a := NewAssets("")
+	if src := a.Source("indicator.png"); src != "embedded" {
+		t.Fatalf("indicator.png is loaded from %q, expected embedded", src)
+	}
+	if _, err := a.Image("indicator.png"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAssetsOverride(t *testing.T) {
+	red, blue := color.NRGBA{255, 0, 0, 255}, color.NRGBA{0, 0, 255, 255}
+	pack := fstest.MapFS{
+		"indicator.png": {Data: pngData(t, red)},
+		"broken.png":    {Data: []byte("not a png")},
+	}
+	base := fstest.MapFS{
+		"indicator.png":   {Data: pngData(t, blue)},
+		"fonts/mono.ttf":  {Data: []byte("font")},
+		"models/ship.obj": {Data: []byte("o ship\n")},
+	}
+	a := newAssets(assetLayer{"pack", pack}, assetLayer{"embedded", base})
+
+	for _, tc := range []struct {
+		name, source string
+	}{
+		{"indicator.png", "pack"},
+		{"/fonts/mono.ttf", "embedded"},
+		{"models/../fonts/mono.ttf", "embedded"},
+		{"missing.png", ""},
+		{"../outside.png", ""},
+	} {
+		if got := a.Source(tc.name); got != tc.source {
+			t.Errorf("%s is loaded from %q, expected %q", tc.name, got, tc.source)
+		}
+	}
+
+	img, err := a.Image("indicator.png")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := img.RGBAAt(0, 0); got != (color.RGBA{255, 0, 0, 255}) {
+		t.Errorf("indicator.png is %v, expected the pack's red", got)
+	}
+	if again, _ := a.Image("indicator.png"); again != img {
+		t.Error("the image is decoded again instead of cached")
+	}
+
+	if _, err := a.Image("broken.png"); err == nil {
+		t.Error("the broken image is decoded")
+	}
+	if _, err := a.Image("missing.png"); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("missing image returns %v, expected not exist", err)
+	}
+	a.Image("missing.png")
+	names, errs := a.Missing()
+	if len(names) != 2 || names[0] != "broken.png" || names[1] != "missing.png" || len(errs) != 2 {
+		t.Errorf("missing assets are %v, expected broken.png and missing.png", names)
+	}
+}
+
+func TestResourcePackDir(t *testing.T) {
+	for _, tc := range []struct {
+		pack, settings, want string
+	}{
+		{"", "/home/u/.config/curve/settings.json", "/home/u/.config/curve/resourcepack"},
+		{"packs/hd", "/home/u/.config/curve/settings.json", "/home/u/.config/curve/packs/hd"},
+		{"/opt/pack", "", "/opt/pack"},
+		{"", "", ""},
+	} {
+		if got := resourcePackDir(AssetSettings{ResourcePack: tc.pack}, tc.settings); got != tc.want {
+			t.Errorf("resourcePackDir(%q, %q) = %q, expected %q", tc.pack, tc.settings, got, tc.want)
+		}
+	}
+}
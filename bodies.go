@@ -0,0 +1,142 @@
+package main
+
+import (
+	"math"
+
+	"github.com/g3n/engine/material"
+	"github.com/g3n/engine/math32"
+)
+
+// objectBlock is a block that knows which object it belongs to
+type objectBlock interface {
+	Block
+	Object() PhysicsObject
+}
+
+// Body is an object tracked by the runner
+type Body struct {
+	Object PhysicsObject
+	Mass   float64
+	Radius float64
+	Color  math32.Color
+}
+
+// Bodies tracks every object which has an objectBlock in the engine, it's updated from the snapshots.
+// The order of the bodies is the order they first appeared.
+type Bodies struct {
+	list  []*Body
+	index map[PhysicsObject]*Body
+	snap  *Snapshot // the snapshot of the last update
+}
+
+func (bs *Bodies) List() []*Body {
+	return bs.list
+}
+
+// Get returns the body of the object, or nil if the object is not tracked
+func (bs *Bodies) Get(o PhysicsObject) *Body {
+	return bs.index[o]
+}
+
+// Next returns the object step bodies after o in the list, skips the ignored object.
+// If o is not tracked, the search starts from the first body.
+func (bs *Bodies) Next(o PhysicsObject, step int, ignore PhysicsObject) PhysicsObject {
+	n := len(bs.list)
+	i := -1
+	if step < 0 {
+		i = 0
+	}
+	for j, b := range bs.list {
+		if b.Object == o {
+			i = j
+			break
+		}
+	}
+	dir := 1
+	if step < 0 {
+		dir, step = -1, -step
+	}
+	for tries := 0; step > 0 && tries < n; tries++ {
+		i = ((i+dir)%n + n) % n
+		if bs.list[i].Object != ignore {
+			step--
+		}
+	}
+	if step > 0 || i < 0 {
+		return nil
+	}
+	return bs.list[i].Object
+}
+
+// defaultBodyColor is the color of the bodies which are neither a player nor a planet
+var defaultBodyColor = math32.Color{0.8, 0.8, 0.8}
+
+// collectBodies sums the blocks of every object which has an objectBlock,
+// it's called by the physics goroutine when the snapshot is taken
+func collectBodies(eng PhysicsBackend) (bodies []Body) {
+	index := make(map[PhysicsObject]int)
+	eng.ForeachBlock(func(b Block) {
+		ob, ok := b.(objectBlock)
+		if !ok {
+			return
+		}
+		o := ob.Object()
+		if o == nil {
+			return
+		}
+		i, ok := index[o]
+		if !ok {
+			i = len(bodies)
+			index[o] = i
+			bodies = append(bodies, Body{Object: o, Color: defaultBodyColor})
+		}
+		body := &bodies[i]
+		body.Mass += b.Mass()
+		switch b := b.(type) {
+		case *Player:
+			body.Color = math32.Color{0.2, 1.0, 0.2}
+		case *PlanetBlock:
+			body.Radius = math.Max(body.Radius, b.radius)
+			if mat, ok := b.mat.(*material.Standard); ok {
+				body.Color = mat.AmbientColor()
+			}
+		}
+	})
+	return
+}
+
+// update syncs the bodies with the snapshot, the bodies which are not in the snapshot are removed
+func (bs *Bodies) update(s *Snapshot) {
+	if s == nil || s == bs.snap {
+		return
+	}
+	bs.snap = s
+	if bs.index == nil {
+		bs.index = make(map[PhysicsObject]*Body)
+	}
+	present := make(map[PhysicsObject]*Body, len(s.Bodies))
+	for i := range s.Bodies {
+		present[s.Bodies[i].Object] = &s.Bodies[i]
+	}
+	list := make([]*Body, 0, len(s.Bodies))
+	for _, body := range bs.list {
+		sb, ok := present[body.Object]
+		if !ok {
+			delete(bs.index, body.Object)
+			continue
+		}
+		body.Mass = sb.Mass
+		body.Radius = sb.Radius
+		list = append(list, body)
+	}
+	for _, sb := range s.Bodies {
+		if _, ok := bs.index[sb.Object]; ok {
+			continue
+		}
+		body := new(Body)
+		*body = sb
+		list = append(list, body)
+		bs.index[sb.Object] = body
+	}
+	bs.list = list
+}
@@ -6,10 +6,8 @@ import (
 
 	"github.com/g3n/engine/camera"
 	"github.com/g3n/engine/core"
-	"github.com/g3n/engine/gui"
 	"github.com/g3n/engine/math32"
 	"github.com/g3n/engine/window"
-	"github.com/go-gl/glfw/v3.3/glfw"
 )
 
 type FollowEnabled int
@@ -47,7 +45,8 @@ const (
 
 type FollowControl struct {
 	core.Dispatcher
-	cam *camera.Camera
+	cam   *camera.Camera
+	input Input
 
 	// status
 	enabled      FollowEnabled
@@ -67,10 +66,11 @@ type FollowControl struct {
 	OnRotate func(pitch, yaw, roll float32) bool
 }
 
-func NewFollowControl(cam *camera.Camera) (fc *FollowControl) {
+func NewFollowControl(cam *camera.Camera, input Input) (fc *FollowControl) {
 	fc = new(FollowControl)
 	fc.Dispatcher.Initialize()
 	fc.cam = cam
+	fc.input = input
 	fc.enabled = FollowAll
 
 	fc.MinFOV = 10.0
@@ -80,25 +80,23 @@ func NewFollowControl(cam *camera.Camera) (fc *FollowControl) {
 	fc.KeyRotSpeed = 30 * math32.Pi / 180
 	fc.KeyZoomSpeed = 5.0
 
-	mnr := gui.Manager()
-	mnr.SubscribeID(window.OnMouseDown, &fc, fc.onMouse)
-	mnr.SubscribeID(window.OnScroll, &fc, fc.onScroll)
-	mnr.SubscribeID(window.OnKeyUp, &fc, fc.onKey)
-	mnr.SubscribeID(window.OnKeyDown, &fc, fc.onKey)
+	input.SubscribeID(window.OnMouseDown, &fc, fc.onMouse)
+	input.SubscribeID(window.OnScroll, &fc, fc.onScroll)
+	input.SubscribeID(window.OnKeyUp, &fc, fc.onKey)
+	input.SubscribeID(window.OnKeyDown, &fc, fc.onKey)
+	input.SubscribeID(window.OnWindowFocus, &fc, fc.onWindowFocus)
 	fc.SubscribeID(window.OnCursor, &fc, fc.onCursor)
-	window.Get().SubscribeID(window.OnWindowFocus, &fc, fc.onWindowFocus)
 
 	return
 }
 
 func (fc *FollowControl) Dispose() {
-	mnr := gui.Manager()
-	mnr.UnsubscribeID(window.OnMouseDown, &fc)
-	mnr.UnsubscribeID(window.OnScroll, &fc)
-	mnr.UnsubscribeID(window.OnKeyUp, &fc)
-	mnr.UnsubscribeID(window.OnKeyDown, &fc)
+	fc.input.UnsubscribeID(window.OnMouseDown, &fc)
+	fc.input.UnsubscribeID(window.OnScroll, &fc)
+	fc.input.UnsubscribeID(window.OnKeyUp, &fc)
+	fc.input.UnsubscribeID(window.OnKeyDown, &fc)
+	fc.input.UnsubscribeID(window.OnWindowFocus, &fc)
 	fc.UnsubscribeID(window.OnCursor, &fc)
-	window.Get().UnsubscribeID(window.OnWindowFocus, &fc)
 }
 
 func (fc *FollowControl) Tick(dt time.Duration) {
@@ -180,14 +178,17 @@ func (fc *FollowControl) SetEnabled(bitmask FollowEnabled) {
 	fc.enabled = bitmask
 }
 
+// Focused reports whether the camera is focusing and the cursor is captured
+func (fc *FollowControl) Focused() bool {
+	return fc.status&followFocusing != 0
+}
+
 // Focus will start focusing the camera and disable the cursor
 func (fc *FollowControl) Focus() {
 	if fc.status&followFocusing == 0 {
 		fc.status = followFocusing
-		gui.Manager().SetCursorFocus(fc)
-		win := window.Get().(*window.GlfwWindow)
-		win.SetInputMode(glfw.CursorMode, glfw.CursorDisabled)
-		win.SetCursorPos(0, 0)
+		fc.input.SetCursorFocus(fc)
+		fc.input.CaptureCursor()
 		fc.lastX, fc.lastY = 0, 0
 	}
 }
@@ -196,13 +197,8 @@ func (fc *FollowControl) Focus() {
 func (fc *FollowControl) Pause() {
 	if fc.status&followFocusing != 0 {
 		fc.status = 0
-		gui.Manager().SetCursorFocus(nil)
-		win := window.Get().(*window.GlfwWindow)
-		win.SetInputMode(glfw.CursorMode, glfw.CursorNormal)
-		if !fc.lostFocus {
-			w, h := win.GetSize()
-			win.SetCursorPos((float64)(w/2), (float64)(h/2))
-		}
+		fc.input.SetCursorFocus(nil)
+		fc.input.ReleaseCursor(!fc.lostFocus)
 	}
 }
 
@@ -0,0 +1,156 @@
+package main
+
+import (
+	"math"
+	"time"
+
+	mol "github.com/LiterMC/molecular"
+	"github.com/g3n/engine/math32"
+)
+
+type CameraMode int
+
+const (
+	// CameraFirstPerson puts the camera at the player's position
+	CameraFirstPerson CameraMode = iota
+	// CameraChase follows behind the player and faces the player's velocity
+	CameraChase
+	// CameraOrbit orbits around the target, or the player when there is no target, movement keys are disabled
+	CameraOrbit
+	// CameraFree detaches the camera, the movement keys move the camera instead of the player
+	CameraFree
+
+	cameraModeCount
+)
+
+func (m CameraMode) String() string {
+	switch m {
+	case CameraFirstPerson:
+		return "first-person"
+	case CameraChase:
+		return "chase"
+	case CameraOrbit:
+		return "orbit"
+	case CameraFree:
+		return "free"
+	}
+	return "unknown"
+}
+
+type CameraModeConfig struct {
+	Enabled   FollowEnabled
+	MoveSpeed float32
+	// Smoothing is the time constant of the camera's position and rotation smoothing,
+	// 0 means the camera snaps to the target pose
+	Smoothing time.Duration
+	// Distance is how far the camera stays away from the player or the surface of the orbited target, in meters
+	Distance float64
+}
+
+func defaultCameraModes() (modes [cameraModeCount]CameraModeConfig) {
+	modes[CameraFirstPerson] = CameraModeConfig{
+		Enabled:   FollowRot | FollowZoom | FollowMove | FollowKeys,
+		MoveSpeed: 10000.0,
+	}
+	modes[CameraChase] = CameraModeConfig{
+		Enabled:   FollowZoom | FollowMove | FollowKeys,
+		MoveSpeed: 10000.0,
+		Smoothing: time.Millisecond * 200,
+		Distance:  1e6,
+	}
+	modes[CameraOrbit] = CameraModeConfig{
+		Enabled:   FollowRot | FollowZoom | FollowKeys,
+		Smoothing: time.Millisecond * 100,
+		Distance:  1e7,
+	}
+	modes[CameraFree] = CameraModeConfig{
+		Enabled:   FollowRot | FollowZoom | FollowMove | FollowKeys,
+		MoveSpeed: 1e7,
+		Smoothing: time.Millisecond * 50,
+	}
+	return
+}
+
+// smoothFactor returns how much to approach the target in this frame
+func smoothFactor(smoothing time.Duration, dt time.Duration) float64 {
+	if smoothing <= 0 {
+		return 1
+	}
+	return 1 - math.Exp(-dt.Seconds()/smoothing.Seconds())
+}
+
+func (p *Player) CameraMode() CameraMode {
+	return p.mode
+}
+
+// SetCameraMode switches the camera mode at runtime
+func (p *Player) SetCameraMode(mode CameraMode) {
+	if mode < 0 || mode >= cameraModeCount {
+		return
+	}
+	if mode == CameraFree {
+		p.freePos = p.viewPos
+	}
+	p.mode = mode
+	p.ctrl.MoveSpeed = p.Modes[mode].MoveSpeed
+	if p.inputEnabled {
+		p.ctrl.SetEnabled(p.Modes[mode].Enabled)
+	}
+}
+
+// CycleCameraMode switches to the next camera mode
+func (p *Player) CycleCameraMode() {
+	p.SetCameraMode((p.mode + 1) % cameraModeCount)
+}
+
+// ViewPos returns the absolute position of the camera in the current mode
+func (p *Player) ViewPos() mol.Vec3 {
+	return p.viewPos
+}
+
+// updateView updates the camera's pose for the current camera mode,
+// the orbit camera orbits the focus, or the player if focus is nil
+func (p *Player) updateView(f *FrameState, focus *Body, dt time.Duration) {
+	cam := p.ctrl.Camera()
+	cfg := &p.Modes[p.mode]
+	pos := f.AbsPos(p.object)
+	target := pos
+	switch p.mode {
+	case CameraChase:
+		dir := vecNormalized(f.Velocity(p.object))
+		vel := ToG3NVec3(&dir)
+		quat := cam.Quaternion()
+		if vel.LengthSq() > 0 {
+			forward := math32.Vector3{0, 0, -1}
+			forward.ApplyQuaternion(&quat)
+			var delta, facing math32.Quaternion
+			delta.SetFromUnitVectors(&forward, vel)
+			facing.MultiplyQuaternions(&delta, &quat)
+			quat.Slerp(&facing, (float32)(smoothFactor(cfg.Smoothing, dt)))
+			cam.SetQuaternionQuat(&quat)
+		}
+		back := math32.Vector3{0, 0.2, 1}
+		back.ApplyQuaternion(&quat)
+		target = vecAdded(pos, vecScaled(ToMolVec3(&back), cfg.Distance))
+	case CameraOrbit:
+		center, dist := pos, cfg.Distance
+		if focus != nil {
+			center, dist = f.AbsPos(focus.Object), cfg.Distance+focus.Radius
+		}
+		quat := cam.Quaternion()
+		back := math32.Vector3{0, 0, 1}
+		back.ApplyQuaternion(&quat)
+		target = vecAdded(center, vecScaled(ToMolVec3(&back), dist))
+	case CameraFree:
+		target = p.freePos
+	}
+	if !p.viewReady {
+		p.viewPos = target
+		p.viewReady = true
+	} else {
+		diff := target.Subbed(p.viewPos)
+		p.viewPos.Add(vecScaled(diff, smoothFactor(cfg.Smoothing, dt)))
+	}
+	rpos := renderPos(p.viewPos)
+	cam.SetPositionVec(&rpos)
+}
@@ -0,0 +1,235 @@
+package main
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	mol "github.com/LiterMC/molecular"
+	"github.com/g3n/engine/camera"
+	"github.com/g3n/engine/math32"
+	"github.com/g3n/engine/window"
+)
+
+func newTestFollowControl(t *testing.T) (*FollowControl, *fakeInput) {
+	t.Helper()
+	in := newFakeInput()
+	fc := NewFollowControl(camera.NewPerspective(1, 0.01, 1000, 60, camera.Vertical), in)
+	t.Cleanup(fc.Dispose)
+	return fc, in
+}
+
+func closeTo(a, b float32) bool {
+	return math.Abs((float64)(a-b)) < 1e-4
+}
+
+func TestFollowControlMove(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		keys  []window.Key
+		focus bool
+		want  math32.Vector3
+	}{
+		{"Forward", []window.Key{window.KeyW}, true, math32.Vector3{0, 0, -10}},
+		{"Up", []window.Key{window.KeySpace}, true, math32.Vector3{0, 10, 0}},
+		{"Sprint", []window.Key{window.KeyLeftShift, window.KeyD}, true, math32.Vector3{100, 0, 0}},
+		{"Opposite", []window.Key{window.KeyA, window.KeyD}, true, math32.Vector3{}},
+		{"Unfocused", []window.Key{window.KeyW}, false, math32.Vector3{}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			fc, in := newTestFollowControl(t)
+			fc.MoveSpeed = 10
+			if tc.focus {
+				in.Click(window.MouseButtonLeft)
+			}
+			for _, k := range tc.keys {
+				in.KeyDown(k, 0)
+			}
+			fc.Tick(time.Second)
+			if got := fc.Camera().Position(); !closeTo(got.X, tc.want.X) || !closeTo(got.Y, tc.want.Y) || !closeTo(got.Z, tc.want.Z) {
+				t.Errorf("camera moved to %v, expected %v", got, tc.want)
+			}
+
+			// releasing the keys stops the movement
+			for _, k := range tc.keys {
+				in.KeyUp(k)
+			}
+			fc.Tick(time.Second)
+			if got := fc.Camera().Position(); !closeTo(got.X, tc.want.X) || !closeTo(got.Y, tc.want.Y) || !closeTo(got.Z, tc.want.Z) {
+				t.Errorf("camera moved to %v after the keys are released, expected %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestFollowControlMoveHook(t *testing.T) {
+	fc, in := newTestFollowControl(t)
+	fc.MoveSpeed = 10
+	var dist float32
+	var dir math32.Vector3
+	fc.OnMove = func(d float32, direction *math32.Vector3) bool {
+		dist, dir = d, *direction
+		return true
+	}
+	in.Click(window.MouseButtonLeft)
+	in.KeyDown(window.KeyS, 0)
+	fc.Tick(500 * time.Millisecond)
+	if !closeTo(dist, 5) || dir != (math32.Vector3{0, 0, 1}) {
+		t.Errorf("OnMove got %g along %v, expected 5 along +Z", dist, dir)
+	}
+	if got := fc.Camera().Position(); got != (math32.Vector3{}) {
+		t.Errorf("camera moved to %v, expected the hook to handle the movement", got)
+	}
+}
+
+func TestFollowControlRotate(t *testing.T) {
+	fc, in := newTestFollowControl(t)
+	var pitch, yaw, roll float32
+	fc.OnRotate = func(p, y, r float32) bool {
+		pitch, yaw, roll = pitch+p, yaw+y, roll+r
+		return true
+	}
+
+	in.Cursor(10, 10)
+	if pitch != 0 || yaw != 0 {
+		t.Fatalf("rotated by the cursor before focused")
+	}
+
+	in.Click(window.MouseButtonLeft)
+	in.Cursor(10, -20)
+	deg := math32.Pi / 180 * fc.MouseRotSpeed
+	if !closeTo(pitch, 20*deg) || !closeTo(yaw, -10*deg) {
+		t.Errorf("cursor rotated pitch %g yaw %g, expected %g %g", pitch, yaw, 20*deg, -10*deg)
+	}
+
+	pitch, yaw = 0, 0
+	in.KeyDown(window.KeyLeft, 0)
+	in.KeyDown(window.KeyQ, 0)
+	fc.Tick(time.Second)
+	if !closeTo(yaw, fc.KeyRotSpeed) || !closeTo(roll, fc.KeyRotSpeed*2) || pitch != 0 {
+		t.Errorf("keys rotated pitch %g yaw %g roll %g, expected 0 %g %g", pitch, yaw, roll, fc.KeyRotSpeed, fc.KeyRotSpeed*2)
+	}
+
+	// without the hook the camera itself rotates
+	fc.OnRotate = nil
+	in.KeyUp(window.KeyLeft)
+	in.KeyUp(window.KeyQ)
+	in.Cursor(10+90/fc.MouseRotSpeed, -20)
+	var dir math32.Vector3
+	fc.Camera().WorldDirection(&dir)
+	if !closeTo(dir.X, 1) || !closeTo(dir.Z, 0) {
+		t.Errorf("camera faces %v after turning right by 90 degrees, expected +X", dir)
+	}
+}
+
+func TestFollowControlZoom(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		scroll []float32
+		want   float32
+	}{
+		{"In", []float32{5}, 55},
+		{"Out", []float32{-5, -5}, 70},
+		{"ClampMin", []float32{30, 30}, 10},
+		{"ClampMax", []float32{-100}, 100},
+		{"BackFromMin", []float32{100, -5}, 15},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			fc, in := newTestFollowControl(t)
+			for _, y := range tc.scroll {
+				in.Scroll(y)
+			}
+			if got := fc.Camera().Fov(); !closeTo(got, tc.want) {
+				t.Errorf("fov is %g, expected %g", got, tc.want)
+			}
+		})
+	}
+
+	t.Run("Disabled", func(t *testing.T) {
+		fc, in := newTestFollowControl(t)
+		fc.SetEnabled(FollowAll &^ FollowZoom)
+		in.Scroll(5)
+		if got := fc.Camera().Fov(); got != 60 {
+			t.Errorf("fov is %g, expected zoom to be disabled", got)
+		}
+	})
+}
+
+func TestFollowControlFocus(t *testing.T) {
+	fc, in := newTestFollowControl(t)
+	in.Click(window.MouseButtonLeft)
+	if !fc.Focused() || !in.captured || in.cursorFocus != fc {
+		t.Fatal("click did not focus the control")
+	}
+
+	in.KeyDown(window.KeyEscape, 0)
+	if fc.Focused() || in.captured || in.cursorFocus != nil {
+		t.Fatal("escape did not pause the control")
+	}
+	if !in.centered {
+		t.Error("escape did not center the cursor")
+	}
+}
+
+func TestFollowControlLostFocus(t *testing.T) {
+	fc, in := newTestFollowControl(t)
+	fc.MoveSpeed = 10
+	in.Click(window.MouseButtonLeft)
+	in.KeyDown(window.KeyW, 0)
+	in.Focus(false)
+
+	// the control pauses on the next tick, and the held keys are dropped
+	fc.Tick(time.Second)
+	if fc.Focused() || in.captured {
+		t.Fatal("the control is still focused after the window lost focus")
+	}
+	if in.centered {
+		t.Error("the cursor was centered after the window lost focus")
+	}
+	pos := fc.Camera().Position()
+	fc.Tick(time.Second)
+	if got := fc.Camera().Position(); got != pos {
+		t.Errorf("camera kept moving to %v after the window lost focus", got)
+	}
+
+	// losing focus while paused does nothing
+	in.Focus(false)
+	fc.Tick(time.Second)
+	in.Click(window.MouseButtonLeft)
+	fc.Tick(time.Second)
+	if !fc.Focused() {
+		t.Error("a stale lost focus paused the control again")
+	}
+}
+
+func TestOrbitCameraFocus(t *testing.T) {
+	nb := NewNBodyBackend()
+	playerObj := nb.NewObject(LivingObject, nil, mol.Vec3{}, nil)
+	planet := &Body{
+		Object: nb.NewObject(LivingObject, nil, mol.Vec3{}, nil),
+		Radius: 6e6,
+	}
+	f := &FrameState{objects: map[PhysicsObject]ObjectState{
+		playerObj:     {AbsPos: mol.Vec3{1, 2, 3}},
+		planet.Object: {AbsPos: mol.Vec3{1e9, 0, 0}},
+	}}
+	for _, tc := range []struct {
+		name  string
+		focus *Body
+		want  mol.Vec3
+	}{
+		{"Player", nil, mol.Vec3{1, 2, 3 + 1e7}},
+		{"Target", planet, mol.Vec3{1e9, 0, 6e6 + 1e7}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := NewPlayer(camera.NewPerspective(1, 0.01, 1000, 60, camera.Vertical), newFakeInput())
+			t.Cleanup(p.Dispose)
+			p.SetObject(playerObj)
+			p.SetCameraMode(CameraOrbit)
+			p.updateView(f, tc.focus, time.Second)
+			if got := p.ViewPos(); got != tc.want {
+				t.Errorf("camera is at %v, expected %v", got, tc.want)
+			}
+		})
+	}
+}
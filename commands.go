@@ -0,0 +1,587 @@
+package main
+
+import (
+	"fmt"
+	"log/slog"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/LiterMC/curve/units"
+	mol "github.com/LiterMC/molecular"
+	"github.com/g3n/engine/material"
+	"github.com/g3n/engine/math32"
+)
+
+// findEntity returns the object of the entity name, the name is case insensitive
+func (r *Runner) findEntity(name string) PhysicsObject {
+	if o := r.world.Find(name); o != nil {
+		return o
+	}
+	for _, n := range r.world.Names() {
+		if strings.EqualFold(n, name) {
+			return r.world.Find(n)
+		}
+	}
+	return nil
+}
+
+func parseVec3(args []string, parse func(string) (float64, error)) (v mol.Vec3, err error) {
+	if len(args) != 3 {
+		return v, errUsage
+	}
+	if v.X, err = parse(args[0]); err != nil {
+		return
+	}
+	if v.Y, err = parse(args[1]); err != nil {
+		return
+	}
+	v.Z, err = parse(args[2])
+	return
+}
+
+func completeEntities(c *Console, args []string) []string {
+	if len(args) != 1 {
+		return nil
+	}
+	return c.r.world.Names()
+}
+
+func registerConsoleCommands(c *Console) {
+	r := c.r
+	c.Register(&ConsoleCommand{
+		Name:  "help",
+		Usage: "[command]",
+		Help:  "lists the commands or shows the usage of a command",
+		Run: func(c *Console, args []string) error {
+			if len(args) > 0 {
+				cmd, ok := c.commands[args[0]]
+				if !ok {
+					return fmt.Errorf("unknown command %q", args[0])
+				}
+				c.Printf("%s %s: %s", cmd.Name, cmd.Usage, cmd.Help)
+				return nil
+			}
+			for _, name := range c.commandNames() {
+				cmd := c.commands[name]
+				c.Printf("%s %s: %s", cmd.Name, cmd.Usage, cmd.Help)
+			}
+			return nil
+		},
+		Complete: func(c *Console, args []string) []string {
+			if len(args) != 1 {
+				return nil
+			}
+			return c.commandNames()
+		},
+	})
+	c.Register(&ConsoleCommand{
+		Name: "clear",
+		Help: "clears the console",
+		Run: func(c *Console, args []string) error {
+			c.Clear()
+			return nil
+		},
+	})
+	c.Register(&ConsoleCommand{
+		Name:  "exec",
+		Usage: "<file>",
+		Help:  "runs the commands in the script file",
+		Run: func(c *Console, args []string) error {
+			if len(args) != 1 {
+				return errUsage
+			}
+			return c.ExecFile(args[0])
+		},
+	})
+	c.Register(&ConsoleCommand{
+		Name:  "tp",
+		Usage: "<body> [altitude] | <x> <y> <z>",
+		Help:  "teleports to the altitude above the body, or to the position relative to the current anchor",
+		Run: func(c *Console, args []string) error {
+			player := r.playerObj
+			switch len(args) {
+			case 1, 2:
+				o := r.findEntity(args[0])
+				if o == nil {
+					return fmt.Errorf("body %q not found", args[0])
+				}
+				if o == player {
+					return fmt.Errorf("cannot teleport to yourself")
+				}
+				var radius float64
+				if b := r.bodies.Get(o); b != nil {
+					radius = b.Radius
+				}
+				alt := radius
+				if len(args) == 2 {
+					var err error
+					if alt, err = units.ParseDistance(args[1]); err != nil {
+						return err
+					}
+				}
+				r.player.Do(func(player PhysicsObject) {
+					// keep the direction from the body to the player
+					up := vecNormalized(player.AbsPos().Subbed(o.AbsPos()))
+					if up.Len() == 0 {
+						up = mol.Vec3{Y: 1}
+					}
+					player.AttachTo(o)
+					player.SetPos(vecScaled(up, radius+alt))
+					player.SetVelocity(mol.Vec3{})
+				})
+				c.Printf("teleported to %s at %s", r.world.Name(o), units.Distance(alt))
+			case 3:
+				pos, err := parseVec3(args, units.ParseDistance)
+				if err != nil {
+					return err
+				}
+				r.player.Do(func(player PhysicsObject) {
+					player.SetPos(pos)
+				})
+				c.Printf("teleported to %s", formatVec3(pos))
+			default:
+				return errUsage
+			}
+			r.conservation.Reset()
+			return nil
+		},
+		Complete: completeEntities,
+	})
+	c.Register(&ConsoleCommand{
+		Name:  "vel",
+		Usage: "<vx> <vy> <vz> | circular",
+		Help:  "sets the velocity relative to the anchor, or the velocity of a circular orbit",
+		Run: func(c *Console, args []string) error {
+			player := r.playerObj
+			if len(args) == 1 && args[0] == "circular" {
+				anchor := player.AnchorLocked()
+				b := r.bodies.Get(anchor)
+				if b == nil || b.Mass <= 0 {
+					return fmt.Errorf("the anchor has no mass")
+				}
+				dist := player.PosLocked().Len()
+				if dist == 0 {
+					return fmt.Errorf("at the center of the anchor")
+				}
+				mu := gravConst * b.Mass
+				r.player.Do(func(player PhysicsObject) {
+					if player.Anchor() != anchor {
+						// the player left the anchor before the tick
+						return
+					}
+					// keep the current horizontal direction, or go east
+					pos := player.Pos()
+					up := vecNormalized(pos)
+					vel := player.Velocity()
+					dir := vecNormalized(vecAdded(vel, vecScaled(up, -vecDot(vel, up))))
+					if dir.Len() == 0 {
+						dir, _, _ = horizonBasis(pos)
+					}
+					player.SetVelocity(vecScaled(dir, math.Sqrt(mu/pos.Len())))
+				})
+				r.conservation.Reset()
+				c.Printf("velocity set to %s", units.Speed(math.Sqrt(mu/dist)))
+				return nil
+			}
+			vel, err := parseVec3(args, units.ParseSpeed)
+			if err != nil {
+				return err
+			}
+			r.player.Do(func(player PhysicsObject) {
+				player.SetVelocity(vel)
+			})
+			r.conservation.Reset()
+			c.Printf("velocity set to %s", units.Speed(vel.Len()))
+			return nil
+		},
+		Complete: func(c *Console, args []string) []string {
+			if len(args) != 1 {
+				return nil
+			}
+			return []string{"circular"}
+		},
+	})
+	c.Register(&ConsoleCommand{
+		Name:  "spawn",
+		Usage: "<name> <mass> <radius> [distance]",
+		Help:  "spawns a body with the mass in kg in front of the camera, it moves with the player",
+		Run: func(c *Console, args []string) error {
+			if len(args) < 3 || len(args) > 4 {
+				return errUsage
+			}
+			name := args[0]
+			if r.world.Find(name) != nil {
+				return fmt.Errorf("name %q is already used", name)
+			}
+			mass, err := strconv.ParseFloat(args[1], 64)
+			if err != nil || mass <= 0 {
+				return fmt.Errorf("invalid mass %q", args[1])
+			}
+			radius, err := units.ParseDistance(args[2])
+			if err != nil {
+				return err
+			}
+			if radius <= 0 {
+				return fmt.Errorf("radius must be positive")
+			}
+			dist := radius * 4
+			if len(args) == 4 {
+				if dist, err = units.ParseDistance(args[3]); err != nil {
+					return err
+				}
+			}
+			player := r.playerObj
+			var quat math32.Quaternion
+			r.cam.WorldQuaternion(&quat)
+			forward := math32.Vector3{0, 0, -1}
+			forward.ApplyQuaternion(&quat)
+			pos := vecAdded(player.PosLocked(), vecScaled(ToMolVec3(&forward), dist))
+			vel := player.VelocityLocked()
+			r.physics.NewObject(NaturalObject, player.AnchorLocked(), pos, func(o PhysicsObject) {
+				r.world.SetInfo(o, InfoComponent{
+					Name:        name,
+					Kind:        EntityUnknown,
+					Description: "Spawned from the console",
+				})
+				o.SetVelocity(vel)
+				mat := material.NewStandard(&math32.Color{0.8, 0.8, 0.8})
+				r.addPlanet(InitPlanet(r.geometries, o, mass, radius, mat, dist))
+			})
+			r.conservation.Reset()
+			c.Printf("spawned %s at %s", name, units.Distance(dist))
+			return nil
+		},
+	})
+	c.Register(&ConsoleCommand{
+		Name:  "warp",
+		Usage: "[factor]",
+		Help:  fmt.Sprintf("shows or sets the time warp, up to x%g", maxTimeWarp),
+		Run: func(c *Console, args []string) error {
+			switch len(args) {
+			case 0:
+			case 1:
+				warp, err := strconv.ParseFloat(strings.TrimPrefix(args[0], "x"), 64)
+				if err != nil || warp < 0 || math.IsNaN(warp) {
+					return fmt.Errorf("invalid time warp %q", args[0])
+				}
+				r.SetTimeWarp(warp)
+			default:
+				return errUsage
+			}
+			c.Printf("time warp: x%g", r.TimeWarp())
+			return nil
+		},
+	})
+	c.Register(&ConsoleCommand{
+		Name:  "target",
+		Usage: "<body> | none",
+		Help:  "selects the target",
+		Run: func(c *Console, args []string) error {
+			if len(args) != 1 {
+				return errUsage
+			}
+			if args[0] == "none" {
+				r.targeting.SetTarget(nil)
+				return nil
+			}
+			o := r.findEntity(args[0])
+			if o == nil {
+				return fmt.Errorf("body %q not found", args[0])
+			}
+			r.targeting.SetTarget(o)
+			c.Println("target:", r.world.Name(r.targeting.Target()))
+			return nil
+		},
+		Complete: func(c *Console, args []string) []string {
+			if len(args) != 1 {
+				return nil
+			}
+			return append(c.r.world.Names(), "none")
+		},
+	})
+	c.Register(&ConsoleCommand{
+		Name:  "overlay",
+		Usage: "[name [on|off]]",
+		Help:  "lists the overlays or toggles an overlay",
+		Run: func(c *Console, args []string) error {
+			if len(args) == 0 {
+				for _, name := range c.overlayNames() {
+					c.Printf("%s: %v", name, c.overlays[name].enabled())
+				}
+				return nil
+			}
+			ov, ok := c.overlays[args[0]]
+			if !ok {
+				return fmt.Errorf("unknown overlay %q", args[0])
+			}
+			enabled := !ov.enabled()
+			if len(args) == 2 {
+				switch args[1] {
+				case "on":
+					enabled = true
+				case "off":
+					enabled = false
+				default:
+					return errUsage
+				}
+			} else if len(args) > 2 {
+				return errUsage
+			}
+			ov.setEnabled(enabled)
+			c.Printf("%s: %v", args[0], enabled)
+			return nil
+		},
+		Complete: func(c *Console, args []string) []string {
+			switch len(args) {
+			case 1:
+				return c.overlayNames()
+			case 2:
+				return []string{"on", "off"}
+			}
+			return nil
+		},
+	})
+	c.Register(&ConsoleCommand{
+		Name: "dump",
+		Help: "prints the state of the player and the bodies, it's also written to the log",
+		Run: func(c *Console, args []string) error {
+			out := func(format string, args ...any) {
+				line := fmt.Sprintf(format, args...)
+				c.Println(line)
+				consoleLog.Info(line)
+			}
+			player := r.playerObj
+			out("time warp x%g, camera %s, fps %d", r.TimeWarp(), r.player.CameraMode(), r.fps)
+			out("player: anchor=%s pos=%v vel=%v", r.world.Name(player.AnchorLocked()), player.PosLocked(), player.VelocityLocked())
+			for _, b := range r.bodies.List() {
+				out("%s: anchor=%s pos=%v vel=%v mass=%g radius=%g", r.world.Name(b.Object),
+					r.world.Name(b.Object.AnchorLocked()), b.Object.PosLocked(), b.Object.VelocityLocked(), b.Mass, b.Radius)
+			}
+			return nil
+		},
+	})
+	c.Register(&ConsoleCommand{
+		Name:  "logs",
+		Usage: "[lines] [level]",
+		Help:  "shows the recent log lines which are at least the level",
+		Run: func(c *Console, args []string) error {
+			n, level := 20, slog.LevelDebug
+			if len(args) > 2 {
+				return errUsage
+			}
+			if len(args) > 0 {
+				var err error
+				if n, err = strconv.Atoi(args[0]); err != nil || n <= 0 {
+					return fmt.Errorf("invalid line count %q", args[0])
+				}
+			}
+			if len(args) > 1 {
+				if err := level.UnmarshalText([]byte(args[1])); err != nil {
+					return err
+				}
+			}
+			for _, line := range logging.Buffer.Recent(n, level) {
+				c.Println(line.String())
+			}
+			return nil
+		},
+		Complete: func(c *Console, args []string) []string {
+			if len(args) != 2 {
+				return nil
+			}
+			return logLevelNames
+		},
+	})
+	c.Register(&ConsoleCommand{
+		Name:  "loglevel",
+		Usage: "[subsystem|default] [level|reset]",
+		Help:  "shows or sets the log level of the subsystems",
+		Run: func(c *Console, args []string) error {
+			switch len(args) {
+			case 0:
+				c.Println("default:", logging.Level(""))
+				for _, sys := range logSubsystems {
+					c.Printf("%s: %s", sys, logging.Level(sys))
+				}
+				return nil
+			case 1:
+				c.Printf("%s: %s", args[0], logging.Level(logSubsystemName(args[0])))
+				return nil
+			case 2:
+			default:
+				return errUsage
+			}
+			sys := logSubsystemName(args[0])
+			if args[1] == "reset" {
+				if sys == "" {
+					return fmt.Errorf("cannot reset the default level")
+				}
+				logging.ResetLevel(sys)
+			} else {
+				var level slog.Level
+				if err := level.UnmarshalText([]byte(args[1])); err != nil {
+					return err
+				}
+				logging.SetLevel(sys, level)
+			}
+			c.Printf("%s: %s", args[0], logging.Level(sys))
+			return nil
+		},
+		Complete: func(c *Console, args []string) []string {
+			switch len(args) {
+			case 1:
+				return append([]string{"default"}, logSubsystems...)
+			case 2:
+				return append(logLevelNames, "reset")
+			}
+			return nil
+		},
+	})
+	c.Register(&ConsoleCommand{
+		Name:  "debugserver",
+		Usage: "[start [addr] | stop]",
+		Help:  "shows, starts or stops the localhost pprof and metrics server",
+		Run: func(c *Console, args []string) error {
+			if len(args) == 0 {
+				if r.debugSrv == nil {
+					c.Println("debug server is stopped")
+				} else {
+					c.Printf("debug server is listening on http://%s", r.debugSrv.Addr())
+				}
+				return nil
+			}
+			switch args[0] {
+			case "start":
+				if len(args) > 2 {
+					return errUsage
+				}
+				if r.debugSrv != nil {
+					return fmt.Errorf("debug server is already listening on %s", r.debugSrv.Addr())
+				}
+				addr := defaultDebugAddr
+				if len(args) == 2 {
+					addr = args[1]
+				}
+				ds, err := StartDebugServer(r, addr)
+				if err != nil {
+					return err
+				}
+				r.debugSrv = ds
+				c.Printf("debug server is listening on http://%s", ds.Addr())
+			case "stop":
+				if r.debugSrv == nil {
+					return fmt.Errorf("debug server is not running")
+				}
+				err := r.debugSrv.Close()
+				r.debugSrv = nil
+				c.Println("debug server stopped")
+				return err
+			default:
+				return errUsage
+			}
+			return nil
+		},
+		Complete: func(c *Console, args []string) []string {
+			if len(args) != 1 {
+				return nil
+			}
+			return []string{"start", "stop"}
+		},
+	})
+	c.Register(&ConsoleCommand{
+		Name:  "assets",
+		Usage: "[<name>]",
+		Help:  "shows the assets which failed to load, or where an asset is loaded from",
+		Run: func(c *Console, args []string) error {
+			switch len(args) {
+			case 0:
+				names, errs := r.assets.Missing()
+				if len(names) == 0 {
+					c.Println("all assets are loaded")
+				}
+				for i, name := range names {
+					c.Printf("%s: %v", name, errs[i])
+				}
+			case 1:
+				if source := r.assets.Source(args[0]); source != "" {
+					c.Printf("%s is loaded from %s", args[0], source)
+				} else {
+					c.Printf("%s does not exist", args[0])
+				}
+			default:
+				return errUsage
+			}
+			return nil
+		},
+	})
+	c.Register(&ConsoleCommand{
+		Name:  "physics",
+		Usage: "[integrator <name>]",
+		Help:  "shows the physics backend, or changes the integrator of the nbody backend",
+		Run: func(c *Console, args []string) error {
+			nb, isNBody := r.physics.(*NBodyBackend)
+			switch len(args) {
+			case 0:
+				if isNBody {
+					c.Printf("backend: nbody, integrator: %s", nb.Integrator)
+				} else {
+					c.Println("backend: molecular")
+				}
+				return nil
+			case 2:
+				if args[0] != "integrator" {
+					return errUsage
+				}
+				if !isNBody {
+					return fmt.Errorf("the integrator can only be changed with the nbody backend")
+				}
+				integrator, err := ParseIntegrator(args[1])
+				if err != nil {
+					return err
+				}
+				nb.SetIntegrator(integrator)
+				c.Printf("integrator: %s", integrator)
+				return nil
+			}
+			return errUsage
+		},
+		Complete: func(c *Console, args []string) []string {
+			switch len(args) {
+			case 1:
+				return []string{"integrator"}
+			case 2:
+				return integratorNames
+			}
+			return nil
+		},
+	})
+	c.Register(&ConsoleCommand{
+		Name:  "drift",
+		Usage: "[reset]",
+		Help:  "shows the energy and momentum drift of the simulation, or measures a new baseline",
+		Run: func(c *Console, args []string) error {
+			switch {
+			case len(args) == 1 && args[0] == "reset":
+				r.conservation.Reset()
+				c.Println("the next measurement is the new baseline")
+			case len(args) == 0:
+				d, ok := r.conservation.Drift()
+				if !ok {
+					c.Println("no baseline yet")
+				} else {
+					c.Println(d.String())
+				}
+			default:
+				return errUsage
+			}
+			return nil
+		},
+		Complete: func(c *Console, args []string) []string {
+			if len(args) != 1 {
+				return nil
+			}
+			return []string{"reset"}
+		},
+	})
+}
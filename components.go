@@ -0,0 +1,54 @@
+package main
+
+import (
+	"time"
+
+	mol "github.com/LiterMC/molecular"
+	"github.com/g3n/engine/core"
+)
+
+// MeshComponent is the scene node which follows the entity's object
+type MeshComponent struct {
+	Node core.INode
+	// LOD is called with the distance in meters between the camera and the object, it can be nil
+	LOD func(dist float64)
+}
+
+// LabelComponent shows the entity in the body labels
+type LabelComponent struct {
+	Text string // overrides the entity's name when it's not empty
+}
+
+type Tickable interface {
+	Tick(dt time.Duration)
+}
+
+// ControllerComponent is ticked by the input system every frame
+type ControllerComponent struct {
+	Control Tickable
+}
+
+// ThrusterComponent changes the velocity of the object.
+// The changes are pushed by the controllers, and the thrusters system queues them to Changes every frame.
+type ThrusterComponent struct {
+	Changes *ChangeQueue // applied by the Tick of the object's block, the changes are dropped when it's nil
+
+	dv mol.Vec3
+}
+
+// Push adds a velocity change in m/s to the next flush
+func (t *ThrusterComponent) Push(dv mol.Vec3) {
+	t.dv.Add(dv)
+}
+
+// flush queues the pushed velocity changes as one change
+func (t *ThrusterComponent) flush() {
+	dv := t.dv
+	t.dv = mol.Vec3{}
+	if dv == (mol.Vec3{}) || t.Changes == nil {
+		return
+	}
+	t.Changes.Do(func(o PhysicsObject) {
+		o.SetVelocity(vecAdded(o.Velocity(), dv))
+	})
+}
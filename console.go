@@ -0,0 +1,369 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/g3n/engine/gui"
+	"github.com/g3n/engine/math32"
+	"github.com/g3n/engine/window"
+)
+
+var errUsage = errors.New("wrong usage")
+
+// ConsoleCommand is a command which can be run in the console or in a script
+type ConsoleCommand struct {
+	Name  string
+	Usage string
+	Help  string
+	Run   func(c *Console, args []string) error
+	// Complete returns the candidates of the last argument, it's optional
+	Complete func(c *Console, args []string) []string
+}
+
+type consoleOverlay struct {
+	enabled    func() bool
+	setEnabled func(bool)
+}
+
+// Console is the developer console overlay, it's toggled with the grave accent key
+type Console struct {
+	r        *Runner
+	commands map[string]*ConsoleCommand
+	overlays map[string]consoleOverlay
+
+	lines      []string
+	history    []string
+	historyPos int
+
+	panel        *gui.Panel
+	output       *gui.Label
+	input        *gui.Edit
+	focusPending bool
+
+	// configs
+	MaxLines   int // lines kept in the output
+	ShownLines int // lines visible in the output
+	MaxHistory int
+}
+
+func NewConsole(r *Runner) (c *Console) {
+	c = new(Console)
+	c.r = r
+	c.commands = make(map[string]*ConsoleCommand)
+	c.overlays = make(map[string]consoleOverlay)
+	c.MaxLines = 500
+	c.ShownLines = 16
+	c.MaxHistory = 100
+
+	const width = 800
+	c.panel = gui.NewPanel(width+10, 0)
+	c.panel.SetPaddings(5, 5, 5, 5)
+	c.panel.SetColor4(&math32.Color4{0.1, 0.1, 0.1, 0.85})
+	c.panel.SetVisible(false)
+	c.output = gui.NewLabel("")
+	c.output.SetColor(&math32.Color{0.9, 0.9, 0.9})
+	c.panel.Add(c.output)
+	c.input = gui.NewEdit(width, "command, tab to complete")
+	c.input.MaxLength = 256
+	c.input.Subscribe(gui.OnKeyDown, c.onInputKey)
+	c.input.Subscribe(gui.OnKeyRepeat, c.onInputKey)
+	c.panel.Add(c.input)
+	c.Clear()
+
+	registerConsoleCommands(c)
+	gui.Manager().SubscribeID(window.OnKeyDown, &c, c.onKey)
+	return
+}
+
+func (c *Console) Dispose() {
+	gui.Manager().UnsubscribeID(window.OnKeyDown, &c)
+}
+
+// Panel returns the gui panel of the console
+func (c *Console) Panel() gui.IPanel {
+	return c.panel
+}
+
+// Register adds a command, the command with the same name is replaced
+func (c *Console) Register(cmd *ConsoleCommand) {
+	c.commands[cmd.Name] = cmd
+}
+
+// AddOverlay registers an overlay which can be toggled by the overlay command
+func (c *Console) AddOverlay(name string, enabled func() bool, setEnabled func(bool)) {
+	c.overlays[name] = consoleOverlay{enabled, setEnabled}
+}
+
+func (c *Console) commandNames() []string {
+	names := make([]string, 0, len(c.commands))
+	for name := range c.commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (c *Console) overlayNames() []string {
+	names := make([]string, 0, len(c.overlays))
+	for name := range c.overlays {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Println appends a line to the output
+func (c *Console) Println(args ...any) {
+	c.print(strings.TrimSuffix(fmt.Sprintln(args...), "\n"))
+}
+
+func (c *Console) Printf(format string, args ...any) {
+	c.print(fmt.Sprintf(format, args...))
+}
+
+func (c *Console) print(text string) {
+	c.lines = append(c.lines, strings.Split(text, "\n")...)
+	if over := len(c.lines) - c.MaxLines; over > 0 {
+		c.lines = append(c.lines[:0], c.lines[over:]...)
+	}
+	shown := c.lines
+	if len(shown) > c.ShownLines {
+		shown = shown[len(shown)-c.ShownLines:]
+	}
+	c.output.SetText(strings.Join(shown, "\n"))
+	c.input.SetPositionY(c.output.Height() + 4)
+	c.panel.SetContentHeight(c.input.Position().Y + c.input.Height())
+}
+
+// Clear clears the output
+func (c *Console) Clear() {
+	c.lines = c.lines[:0]
+	c.output.SetText("")
+	c.input.SetPositionY(0)
+	c.panel.SetContentHeight(c.input.Height())
+}
+
+// splitCommand splits the line into words, the double quoted words may contain spaces
+func splitCommand(line string) (words []string) {
+	var (
+		word    strings.Builder
+		inWord  bool
+		inQuote bool
+	)
+	for _, ch := range line {
+		switch {
+		case ch == '"':
+			inQuote = !inQuote
+			inWord = true
+		case !inQuote && (ch == ' ' || ch == '\t'):
+			if inWord {
+				words = append(words, word.String())
+				word.Reset()
+				inWord = false
+			}
+		default:
+			word.WriteRune(ch)
+			inWord = true
+		}
+	}
+	if inWord {
+		words = append(words, word.String())
+	}
+	return
+}
+
+// Exec runs a command line, the lines start with # are ignored
+func (c *Console) Exec(line string) error {
+	line = strings.TrimSpace(line)
+	if line == "" || line[0] == '#' {
+		return nil
+	}
+	words := splitCommand(line)
+	if len(words) == 0 {
+		return nil
+	}
+	cmd, ok := c.commands[words[0]]
+	if !ok {
+		return fmt.Errorf("unknown command %q, try help", words[0])
+	}
+	if err := cmd.Run(c, words[1:]); err != nil {
+		if errors.Is(err, errUsage) {
+			return fmt.Errorf("usage: %s %s", cmd.Name, cmd.Usage)
+		}
+		return err
+	}
+	return nil
+}
+
+// ExecFile runs every line of the script file, it stops at the first error
+func (c *Console) ExecFile(path string) (err error) {
+	fd, err := os.Open(path)
+	if err != nil {
+		return
+	}
+	defer fd.Close()
+	sc := bufio.NewScanner(fd)
+	for n := 1; sc.Scan(); n++ {
+		if err = c.Exec(sc.Text()); err != nil {
+			return fmt.Errorf("%s:%d: %w", path, n, err)
+		}
+	}
+	return sc.Err()
+}
+
+// Complete returns the line with its last word completed.
+// The candidates are printed if there are more than one.
+func (c *Console) Complete(line string) string {
+	words := splitCommand(line)
+	if len(words) == 0 || strings.HasSuffix(line, " ") {
+		words = append(words, "")
+	}
+	last := words[len(words)-1]
+	var candidates []string
+	if len(words) == 1 {
+		candidates = c.commandNames()
+	} else if cmd, ok := c.commands[words[0]]; ok && cmd.Complete != nil {
+		candidates = cmd.Complete(c, words[1:])
+	}
+	matched := make([]string, 0, len(candidates))
+	for _, s := range candidates {
+		if strings.HasPrefix(strings.ToLower(s), strings.ToLower(last)) {
+			matched = append(matched, s)
+		}
+	}
+	if len(matched) == 0 {
+		return line
+	}
+	completed := matched[0]
+	for _, s := range matched[1:] {
+		completed = commonPrefix(completed, s)
+	}
+	if len(matched) > 1 {
+		c.Println(strings.Join(matched, "  "))
+		if len(completed) < len(last) {
+			return line
+		}
+	}
+	if strings.ContainsAny(completed, " \t") {
+		completed = `"` + completed + `"`
+	}
+	words[len(words)-1] = completed
+	line = strings.Join(words, " ")
+	if len(matched) == 1 {
+		line += " "
+	}
+	return line
+}
+
+func commonPrefix(a, b string) string {
+	n := 0
+	for n < len(a) && n < len(b) && a[n] == b[n] {
+		n++
+	}
+	return a[:n]
+}
+
+func (c *Console) Opened() bool {
+	return c.panel.Visible()
+}
+
+// Open shows the console and releases the cursor
+func (c *Console) Open() {
+	if c.Opened() {
+		return
+	}
+	c.r.player.ctrl.Pause()
+	c.panel.SetVisible(true)
+	// the key focus is moved in the next frame,
+	// so the char event of the toggle key is not typed into the input
+	c.focusPending = true
+}
+
+func (c *Console) Close() {
+	if !c.Opened() {
+		return
+	}
+	c.panel.SetVisible(false)
+	c.focusPending = false
+	c.input.SetText("")
+	gui.Manager().SetKeyFocus(nil)
+}
+
+func (c *Console) Toggle() {
+	if c.Opened() {
+		c.Close()
+	} else {
+		c.Open()
+	}
+}
+
+func (c *Console) update() {
+	if c.focusPending {
+		c.focusPending = false
+		gui.Manager().SetKeyFocus(c.input)
+	}
+}
+
+func (c *Console) submit() {
+	line := strings.TrimSpace(c.input.Text())
+	c.input.SetText("")
+	if line == "" {
+		return
+	}
+	if len(c.history) == 0 || c.history[len(c.history)-1] != line {
+		c.history = append(c.history, line)
+		if over := len(c.history) - c.MaxHistory; over > 0 {
+			c.history = append(c.history[:0], c.history[over:]...)
+		}
+	}
+	c.historyPos = len(c.history)
+	c.Println("> " + line)
+	if err := c.Exec(line); err != nil {
+		c.Println("error:", err)
+	}
+}
+
+func (c *Console) browseHistory(step int) {
+	pos := c.historyPos + step
+	if pos < 0 || pos > len(c.history) {
+		return
+	}
+	c.historyPos = pos
+	if pos == len(c.history) {
+		c.input.SetText("")
+	} else {
+		c.input.SetText(c.history[pos])
+	}
+	c.input.CursorEnd()
+}
+
+func (c *Console) onKey(evname string, ev any) {
+	kev := ev.(*window.KeyEvent)
+	switch kev.Key {
+	case window.KeyGraveAccent:
+		c.Toggle()
+	}
+}
+
+// onInputKey handles the keys which are not used by the edit
+func (c *Console) onInputKey(evname string, ev any) {
+	kev := ev.(*window.KeyEvent)
+	switch kev.Key {
+	case window.KeyGraveAccent, window.KeyEscape:
+		c.Close()
+	case window.KeyEnter, window.KeyKPEnter:
+		c.submit()
+	case window.KeyUp:
+		c.browseHistory(-1)
+	case window.KeyDown:
+		c.browseHistory(1)
+	case window.KeyTab:
+		c.input.SetText(c.Complete(c.input.Text()))
+		c.input.CursorEnd()
+	}
+}
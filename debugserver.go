@@ -0,0 +1,155 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"sync"
+	"time"
+)
+
+// DebugSettings configures the debug HTTP server
+type DebugSettings struct {
+	// Listen is the localhost address of the debug server, the server is disabled if it's empty
+	Listen string `json:"listen,omitempty"`
+}
+
+const defaultDebugAddr = "127.0.0.1:6060"
+
+// checkLoopback makes sure the address can only be reached from the local machine
+func checkLoopback(addr string) error {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return err
+	}
+	if host == "localhost" {
+		return nil
+	}
+	if ip := net.ParseIP(host); ip != nil && ip.IsLoopback() {
+		return nil
+	}
+	return fmt.Errorf("debug server must listen on a loopback address, got %q", addr)
+}
+
+var publishExpvarOnce sync.Once
+
+// DebugServer serves pprof, expvar and Prometheus format metrics on localhost
+type DebugServer struct {
+	r        *Runner
+	server   *http.Server
+	listener net.Listener
+}
+
+// StartDebugServer listens on the loopback address and serves in a new goroutine
+func StartDebugServer(r *Runner, addr string) (ds *DebugServer, err error) {
+	if err = checkLoopback(addr); err != nil {
+		return
+	}
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return
+	}
+	ds = &DebugServer{
+		r:        r,
+		listener: listener,
+	}
+	publishExpvarOnce.Do(func() {
+		expvar.Publish("curve", expvar.Func(func() any {
+			return r.metricsSnapshot()
+		}))
+	})
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	mux.Handle("/debug/vars", expvar.Handler())
+	mux.HandleFunc("/metrics", ds.serveMetrics)
+	ds.server = &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		if err := ds.server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			coreLog.Error("debug server stopped", "err", err)
+		}
+	}()
+	coreLog.Info("debug server started", "addr", ds.Addr())
+	return
+}
+
+func (ds *DebugServer) Addr() string {
+	return ds.listener.Addr().String()
+}
+
+// Close stops the server and waits a few seconds for the running requests
+func (ds *DebugServer) Close() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	return ds.server.Shutdown(ctx)
+}
+
+// metricsWindow is the time range of the quantiles in the metrics
+const metricsWindow = 10 * time.Second
+
+// metricsSnapshot returns the metrics for expvar
+func (r *Runner) metricsSnapshot() map[string]any {
+	since := time.Now().Add(-metricsWindow)
+	summary := func(s *PerfSeries) map[string]any {
+		sum := s.Summary(since)
+		count, total := s.Totals()
+		return map[string]any{
+			"min":   sum.Min,
+			"avg":   sum.Avg,
+			"p99":   sum.P99,
+			"max":   sum.Max,
+			"count": count,
+			"total": total,
+		}
+	}
+	objects, _ := r.perf.Objects.Last()
+	return map[string]any{
+		"frame_ms":     summary(r.perf.Frame),
+		"tick_ms":      summary(r.perf.Tick),
+		"events":       summary(r.perf.Events),
+		"objects":      objects,
+		"lod_rebuilds": lodRebuilds.Load(),
+		"time_warp":    r.TimeWarp(),
+	}
+}
+
+func writeMetricSummary(w io.Writer, name, help string, s *PerfSeries, since time.Time, scale float64) {
+	sum := s.Summary(since)
+	count, total := s.Totals()
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s summary\n", name, help, name)
+	fmt.Fprintf(w, "%s{quantile=\"0\"} %g\n", name, sum.Min*scale)
+	fmt.Fprintf(w, "%s{quantile=\"0.99\"} %g\n", name, sum.P99*scale)
+	fmt.Fprintf(w, "%s{quantile=\"1\"} %g\n", name, sum.Max*scale)
+	fmt.Fprintf(w, "%s_sum %g\n%s_count %d\n", name, total*scale, name, count)
+}
+
+func writeMetric(w io.Writer, name, typ, help string, value float64) {
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n%s %g\n", name, help, name, typ, name, value)
+}
+
+// serveMetrics writes the metrics in the Prometheus text format,
+// the quantiles are calculated from the samples of the last metricsWindow
+func (ds *DebugServer) serveMetrics(rw http.ResponseWriter, req *http.Request) {
+	r := ds.r
+	since := time.Now().Add(-metricsWindow)
+	rw.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	writeMetricSummary(rw, "curve_frame_seconds", "Render frame time.", r.perf.Frame, since, 1e-3)
+	writeMetricSummary(rw, "curve_physics_tick_seconds", "Physics tick duration.", r.perf.Tick, since, 1e-3)
+	writeMetricSummary(rw, "curve_physics_events", "Physics events per tick.", r.perf.Events, since, 1)
+	objects, _ := r.perf.Objects.Last()
+	writeMetric(rw, "curve_objects", "gauge", "Bodies in the scene.", objects)
+	writeMetric(rw, "curve_lod_rebuilds_total", "counter", "Planet geometry rebuilds.", (float64)(lodRebuilds.Load()))
+	writeMetric(rw, "curve_time_warp", "gauge", "Simulation time warp factor.", r.TimeWarp())
+}
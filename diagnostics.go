@@ -0,0 +1,178 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"sync"
+
+	mol "github.com/LiterMC/molecular"
+)
+
+// Conservation is the quantities which should be conserved by the simulation.
+// The potential energy is the sum of the point mass potentials between every pair of objects,
+// and the angular momentum is around the origin.
+type Conservation struct {
+	Kinetic         float64
+	Potential       float64
+	Momentum        mol.Vec3
+	AngularMomentum mol.Vec3
+
+	// the sums of the magnitudes, which are the denominators of the vectors' drift,
+	// since the vectors themselves can be zero
+	momentumScale float64
+	angularScale  float64
+}
+
+func (c Conservation) Energy() float64 {
+	return c.Kinetic + c.Potential
+}
+
+// MeasureConservation measures the objects of the backend, the mass of an object is the sum of its objectBlocks
+func MeasureConservation(eng PhysicsBackend) (c Conservation) {
+	masses := make(map[PhysicsObject]float64)
+	var objects []PhysicsObject
+	eng.ForeachBlock(func(b Block) {
+		ob, ok := b.(objectBlock)
+		if !ok {
+			return
+		}
+		o := ob.Object()
+		if o == nil {
+			return
+		}
+		if _, ok := masses[o]; !ok {
+			objects = append(objects, o)
+		}
+		masses[o] += b.Mass()
+	})
+
+	pos := make([]mol.Vec3, len(objects))
+	for i, o := range objects {
+		m := masses[o]
+		pos[i] = o.AbsPosLocked()
+		var vel mol.Vec3
+		for a := o; a != nil; a = a.AnchorLocked() {
+			vel.Add(a.VelocityLocked())
+		}
+		p := vecScaled(vel, m)
+		l := vecCross(pos[i], p)
+		c.Kinetic += m * vecDot(vel, vel) / 2
+		c.Momentum.Add(p)
+		c.AngularMomentum.Add(l)
+		c.momentumScale += p.Len()
+		c.angularScale += l.Len()
+	}
+	for i, a := range objects {
+		for j := i + 1; j < len(objects); j++ {
+			if r := pos[j].Subbed(pos[i]).Len(); r > 0 {
+				c.Potential -= gravConst * masses[a] * masses[objects[j]] / r
+			}
+		}
+	}
+	return
+}
+
+// ConservationDrift is the relative errors from a baseline
+type ConservationDrift struct {
+	Energy          float64
+	Momentum        float64
+	AngularMomentum float64
+}
+
+// DriftFrom returns the relative drift of c from the baseline
+func (c Conservation) DriftFrom(base Conservation) (d ConservationDrift) {
+	relative := func(diff, scale float64) float64 {
+		if scale == 0 {
+			if diff == 0 {
+				return 0
+			}
+			return math.Inf(1)
+		}
+		return diff / scale
+	}
+	d.Energy = relative(math.Abs(c.Energy()-base.Energy()), math.Abs(base.Energy()))
+	d.Momentum = relative(c.Momentum.Subbed(base.Momentum).Len(), base.momentumScale)
+	d.AngularMomentum = relative(c.AngularMomentum.Subbed(base.AngularMomentum).Len(), base.angularScale)
+	return
+}
+
+// Max returns the largest drift
+func (d ConservationDrift) Max() float64 {
+	return math.Max(d.Energy, math.Max(d.Momentum, d.AngularMomentum))
+}
+
+func (d ConservationDrift) String() string {
+	return fmt.Sprintf("energy %.3g, momentum %.3g, angular momentum %.3g", d.Energy, d.Momentum, d.AngularMomentum)
+}
+
+// ConservationMonitor measures the drift of the simulation every few physics ticks
+type ConservationMonitor struct {
+	mux     sync.Mutex
+	base    Conservation
+	hasBase bool
+	drift   ConservationDrift
+	ticks   int
+	warned  bool
+
+	// configs
+	Interval  int     // physics ticks between the measurements
+	WarnDrift float64 // the drift is logged as a warning once it exceeds this
+}
+
+func NewConservationMonitor() (m *ConservationMonitor) {
+	m = new(ConservationMonitor)
+	m.Interval = 100
+	m.WarnDrift = 1e-3
+	return
+}
+
+// tick is called by the physics goroutine after every tick
+func (m *ConservationMonitor) tick(eng PhysicsBackend) {
+	m.mux.Lock()
+	if m.ticks++; m.ticks < m.Interval && m.hasBase {
+		m.mux.Unlock()
+		return
+	}
+	m.ticks = 0
+	m.mux.Unlock()
+
+	c := MeasureConservation(eng)
+
+	m.mux.Lock()
+	defer m.mux.Unlock()
+	if !m.hasBase {
+		m.base, m.hasBase = c, true
+		m.drift = ConservationDrift{}
+		physicsLog.Debug("conservation baseline", "energy", c.Energy())
+		return
+	}
+	m.drift = c.DriftFrom(m.base)
+	physicsLog.Debug("conservation drift", "energy", m.drift.Energy, "momentum", m.drift.Momentum, "angular", m.drift.AngularMomentum)
+	if !m.warned && m.drift.Max() > m.WarnDrift {
+		m.warned = true
+		physicsLog.Warn("conservation drift exceeded", "limit", m.WarnDrift, "drift", m.drift.String())
+	}
+}
+
+// Reset makes the next measurement the baseline, it should be called after the objects are changed by hand
+func (m *ConservationMonitor) Reset() {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+	m.hasBase = false
+	m.warned = false
+}
+
+// Drift returns the drift of the last measurement, ok is false before there is a baseline
+func (m *ConservationMonitor) Drift() (d ConservationDrift, ok bool) {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+	return m.drift, m.hasBase
+}
+
+// registerHUD registers the drift widget
+func (m *ConservationMonitor) registerHUD(h *HUD) error {
+	return h.Register("drift", "Drift:", func() (string, bool) {
+		d, ok := m.Drift()
+		return fmt.Sprintf("E %.2e  P %.2e  L %.2e", d.Energy, d.Momentum, d.AngularMomentum), ok
+	})
+}
@@ -0,0 +1,161 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	mol "github.com/LiterMC/molecular"
+	"github.com/g3n/engine/core"
+)
+
+// newHeadlessRunner builds the default scenario on the backend without a window
+func newHeadlessRunner(t *testing.T, physics PhysicsBackend) *Runner {
+	t.Helper()
+	r := new(Runner)
+	r.physics = physics
+	r.world = NewWorld()
+	r.mainScene = core.NewNode()
+	r.geometries = NewGeometryCache(1)
+	t.Cleanup(r.geometries.Dispose)
+	r.playerObj = physics.NewObject(LivingObject, nil, mol.Vec3{}, func(o PhysicsObject) {
+		o.AddBlock(&massBlock{mass: 1e3})
+	})
+	r.initSunMoon()
+	return r
+}
+
+// newTestNBody returns an nbody backend which substeps every minute
+func newTestNBody(integrator Integrator) *NBodyBackend {
+	nb := NewNBodyBackend()
+	nb.Integrator = integrator
+	nb.MaxStep = time.Minute
+	return nb
+}
+
+// tickDay ticks the backend through a simulated day in minute ticks,
+// which are the physics ticks at the time warp x6000
+func tickDay(physics PhysicsBackend) {
+	const dt = time.Minute
+	for elapsed := time.Duration(0); elapsed < 24*time.Hour; elapsed += dt {
+		physics.Tick(dt)
+	}
+}
+
+// TestConservationDriftOverYear ticks the backends which are created like the game does with its physics tick,
+// for a day at the time warp x1, and for a year at the maximum time warp.
+func TestConservationDriftOverYear(t *testing.T) {
+	const (
+		day  = 24 * time.Hour
+		year = 366 * day
+	)
+	for _, tc := range []struct {
+		name     string
+		settings PhysicsSettings
+		warp     float64
+		span     time.Duration
+		// the limits are about a hundred times the worst drifts which are measured
+		maxDrift float64
+		skip     string
+	}{
+		{
+			name:     "molecular/x1",
+			settings: PhysicsSettings{Backend: "molecular"},
+			warp:     1,
+			span:     day,
+			skip:     "the drift of the molecular engine is not measured yet",
+		},
+		{
+			name:     "molecular/max",
+			settings: PhysicsSettings{Backend: "molecular"},
+			warp:     maxTimeWarp,
+			span:     year,
+			skip:     "the drift of the molecular engine is not measured yet",
+		},
+		// the worst drift is 1.2e-9 of the momentum
+		{
+			name:     "nbody/x1",
+			settings: PhysicsSettings{Backend: "nbody", Integrator: "leapfrog"},
+			warp:     1,
+			span:     day,
+			maxDrift: 1e-7,
+		},
+		// the worst drifts are 1.5e-10 of the momentum
+		{
+			name:     "nbody/max",
+			settings: PhysicsSettings{Backend: "nbody", Integrator: "leapfrog"},
+			warp:     maxTimeWarp,
+			span:     year,
+			maxDrift: 1e-8,
+		},
+		{
+			name:     "nbody-rk4/max",
+			settings: PhysicsSettings{Backend: "nbody", Integrator: "rk4"},
+			warp:     maxTimeWarp,
+			span:     year,
+			maxDrift: 1e-8,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.skip != "" {
+				t.Skip(tc.skip)
+			}
+			if testing.Short() {
+				t.Skip("skipping the long simulation in short mode")
+			}
+			physics, err := NewPhysicsBackend(tc.settings)
+			if err != nil {
+				t.Fatal(err)
+			}
+			r := newHeadlessRunner(t, physics)
+			base := MeasureConservation(r.physics)
+			if base.Energy() >= 0 {
+				t.Fatalf("the system is not bound, energy is %g", base.Energy())
+			}
+			dt := (time.Duration)((float64)(physicsTickInterval) * tc.warp)
+			var worst ConservationDrift
+			for elapsed := time.Duration(0); elapsed < tc.span; {
+				// the drift is measured every simulated hour
+				for next := elapsed + time.Hour; elapsed < next; elapsed += dt {
+					r.physics.Tick(dt)
+				}
+				d := MeasureConservation(r.physics).DriftFrom(base)
+				if d.Max() > worst.Max() {
+					worst = d
+				}
+				if d.Max() > tc.maxDrift {
+					t.Fatalf("drift exceeded %g after %s: %s", tc.maxDrift, elapsed, d)
+				}
+			}
+			t.Logf("worst drift: %s", worst)
+		})
+	}
+}
+
+func TestConservationMonitorReset(t *testing.T) {
+	r := newHeadlessRunner(t, newTestNBody(IntegratorLeapfrog))
+	m := NewConservationMonitor()
+	m.Interval = 2
+	if _, ok := m.Drift(); ok {
+		t.Fatal("monitor has a baseline before the first tick")
+	}
+	m.tick(r.physics)
+	if _, ok := m.Drift(); !ok {
+		t.Fatal("the first tick did not measure the baseline")
+	}
+
+	// a body which is not created by the physics
+	r.physics.NewObject(NaturalObject, nil, mol.Vec3{X: 1e12}, func(o PhysicsObject) {
+		o.AddBlock(&massBlock{mass: 1e24})
+		o.SetVelocity(mol.Vec3{Z: 1e3})
+	})
+	m.tick(r.physics)
+	m.tick(r.physics)
+	if d, _ := m.Drift(); d.Energy == 0 {
+		t.Fatal("the impulse did not drift the energy")
+	}
+	m.Reset()
+	m.tick(r.physics)
+	if d, ok := m.Drift(); !ok || d.Max() != 0 {
+		t.Fatalf("drift is %s after reset, expected zero", d)
+	}
+}
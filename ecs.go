@@ -0,0 +1,245 @@
+package main
+
+import (
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+)
+
+// EntityID identifies an entity of the World, zero is never used
+type EntityID uint32
+
+// World links the entities to the physics objects and holds their components.
+// The entities and components are safe to add inside the engine's object callbacks,
+// the systems are only updated by the render thread.
+type World struct {
+	mux        sync.RWMutex
+	nextID     EntityID
+	objects    map[EntityID]PhysicsObject
+	ids        map[PhysicsObject]EntityID
+	components map[reflect.Type]*componentStore
+
+	systems []*systemEntry
+}
+
+type componentStore struct {
+	ids []EntityID // in the order of spawning
+	m   map[EntityID]any
+}
+
+func NewWorld() (w *World) {
+	w = new(World)
+	w.objects = make(map[EntityID]PhysicsObject)
+	w.ids = make(map[PhysicsObject]EntityID)
+	w.components = make(map[reflect.Type]*componentStore)
+	return
+}
+
+// Spawn returns the entity of the object, a new entity is created if the object doesn't have one.
+// The object can be nil for the entities which are not in the physics.
+func (w *World) Spawn(o PhysicsObject) EntityID {
+	w.mux.Lock()
+	defer w.mux.Unlock()
+	if o != nil {
+		if id, ok := w.ids[o]; ok {
+			return id
+		}
+	}
+	w.nextID++
+	id := w.nextID
+	w.objects[id] = o
+	if o != nil {
+		w.ids[o] = id
+	}
+	return id
+}
+
+// Despawn removes the entity and all of its components
+func (w *World) Despawn(id EntityID) {
+	w.mux.Lock()
+	defer w.mux.Unlock()
+	o, ok := w.objects[id]
+	if !ok {
+		return
+	}
+	delete(w.objects, id)
+	if o != nil {
+		delete(w.ids, o)
+	}
+	for _, s := range w.components {
+		if _, ok := s.m[id]; ok {
+			s.remove(id)
+		}
+	}
+}
+
+// Lookup returns the entity of the object
+func (w *World) Lookup(o PhysicsObject) (id EntityID, ok bool) {
+	w.mux.RLock()
+	defer w.mux.RUnlock()
+	id, ok = w.ids[o]
+	return
+}
+
+// Object returns the physics object of the entity, or nil
+func (w *World) Object(id EntityID) PhysicsObject {
+	w.mux.RLock()
+	defer w.mux.RUnlock()
+	return w.objects[id]
+}
+
+func (s *componentStore) remove(id EntityID) {
+	delete(s.m, id)
+	i := sort.Search(len(s.ids), func(i int) bool { return s.ids[i] >= id })
+	if i < len(s.ids) && s.ids[i] == id {
+		s.ids = append(s.ids[:i], s.ids[i+1:]...)
+	}
+}
+
+func componentType[T any]() reflect.Type {
+	return reflect.TypeOf((*T)(nil))
+}
+
+// AddComponent attaches c to the entity, it replaces the entity's previous component of the same type
+func AddComponent[T any](w *World, id EntityID, c *T) {
+	w.mux.Lock()
+	defer w.mux.Unlock()
+	if _, ok := w.objects[id]; !ok {
+		return
+	}
+	typ := componentType[T]()
+	s := w.components[typ]
+	if s == nil {
+		s = &componentStore{m: make(map[EntityID]any)}
+		w.components[typ] = s
+	}
+	if _, ok := s.m[id]; !ok {
+		i := sort.Search(len(s.ids), func(i int) bool { return s.ids[i] >= id })
+		s.ids = append(s.ids, 0)
+		copy(s.ids[i+1:], s.ids[i:])
+		s.ids[i] = id
+	}
+	s.m[id] = c
+}
+
+// RemoveComponent detaches the component of the type T from the entity
+func RemoveComponent[T any](w *World, id EntityID) {
+	w.mux.Lock()
+	defer w.mux.Unlock()
+	if s := w.components[componentType[T]()]; s != nil {
+		if _, ok := s.m[id]; ok {
+			s.remove(id)
+		}
+	}
+}
+
+// GetComponent returns the component of the type T of the entity, or nil
+func GetComponent[T any](w *World, id EntityID) *T {
+	w.mux.RLock()
+	defer w.mux.RUnlock()
+	if s := w.components[componentType[T]()]; s != nil {
+		c, _ := s.m[id].(*T)
+		return c
+	}
+	return nil
+}
+
+// ObjectComponent returns the component of the type T of the object's entity, or nil
+func ObjectComponent[T any](w *World, o PhysicsObject) *T {
+	id, ok := w.Lookup(o)
+	if !ok {
+		return nil
+	}
+	return GetComponent[T](w, id)
+}
+
+// Each calls fn with every entity which has a component of the type T, in the order of spawning.
+// fn is called without holding the lock, so it can change the world.
+func Each[T any](w *World, fn func(id EntityID, o PhysicsObject, c *T)) {
+	type entry struct {
+		id EntityID
+		o  PhysicsObject
+		c  *T
+	}
+	w.mux.RLock()
+	s := w.components[componentType[T]()]
+	if s == nil {
+		w.mux.RUnlock()
+		return
+	}
+	entries := make([]entry, len(s.ids))
+	for i, id := range s.ids {
+		entries[i] = entry{id, w.objects[id], s.m[id].(*T)}
+	}
+	w.mux.RUnlock()
+	for _, e := range entries {
+		fn(e.id, e.o, e.c)
+	}
+}
+
+// The priorities of the built-in system stages, a system runs after all systems with lower priorities
+const (
+	PriorityEvents      = 50
+	PriorityInput       = 100
+	PriorityPhysicsSync = 200
+	PriorityLOD         = 300
+	PriorityHUD         = 400
+)
+
+// System is a step of the frame
+type System interface {
+	Update(dt time.Duration)
+}
+
+// SystemFunc adapts a function to a System
+type SystemFunc func(dt time.Duration)
+
+func (f SystemFunc) Update(dt time.Duration) {
+	f(dt)
+}
+
+type systemEntry struct {
+	name     string
+	priority int
+	system   System
+}
+
+// AddSystem adds a system which runs at the priority,
+// the systems of the same priority run in the order they are added.
+// A system with the same name is replaced.
+func (w *World) AddSystem(name string, priority int, s System) {
+	w.RemoveSystem(name)
+	e := &systemEntry{name: name, priority: priority, system: s}
+	i := sort.Search(len(w.systems), func(i int) bool { return w.systems[i].priority > priority })
+	w.systems = append(w.systems, nil)
+	copy(w.systems[i+1:], w.systems[i:])
+	w.systems[i] = e
+}
+
+// RemoveSystem removes the system, and reports whether it existed
+func (w *World) RemoveSystem(name string) bool {
+	for i, e := range w.systems {
+		if e.name == name {
+			w.systems = append(w.systems[:i], w.systems[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// Systems returns the names of the systems in the order they run
+func (w *World) Systems() (names []string) {
+	names = make([]string, len(w.systems))
+	for i, e := range w.systems {
+		names[i] = e.name
+	}
+	return
+}
+
+// Update runs all systems once
+func (w *World) Update(dt time.Duration) {
+	for _, e := range w.systems {
+		e.system.Update(dt)
+	}
+}
@@ -0,0 +1,154 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	mol "github.com/LiterMC/molecular"
+)
+
+func TestWorldComponents(t *testing.T) {
+	w := NewWorld()
+	nb := NewNBodyBackend()
+	a := nb.NewObject(NaturalObject, nil, mol.Vec3{}, nil)
+	b := nb.NewObject(NaturalObject, nil, mol.Vec3{}, nil)
+
+	ida := w.Spawn(a)
+	if id := w.Spawn(a); id != ida {
+		t.Fatalf("spawned %d again for the same object, expected %d", id, ida)
+	}
+	idb := w.Spawn(b)
+	idn := w.Spawn(nil)
+	if w.Object(idb) != b || w.Object(idn) != nil {
+		t.Fatal("entities are not linked to their objects")
+	}
+
+	AddComponent(w, idb, &LabelComponent{Text: "b"})
+	AddComponent(w, ida, &LabelComponent{Text: "a"})
+	AddComponent(w, idn, &LabelComponent{Text: "n"})
+	AddComponent(w, ida, &ThrusterComponent{})
+	if c := ObjectComponent[LabelComponent](w, a); c == nil || c.Text != "a" {
+		t.Fatalf("label of a is %v", c)
+	}
+	if c := GetComponent[ThrusterComponent](w, idb); c != nil {
+		t.Fatal("b has a thruster which is never added")
+	}
+
+	var texts []string
+	Each(w, func(_ EntityID, _ PhysicsObject, c *LabelComponent) {
+		texts = append(texts, c.Text)
+	})
+	if want := []string{"a", "b", "n"}; !reflect.DeepEqual(texts, want) {
+		t.Errorf("iterated labels %v, expected the spawning order %v", texts, want)
+	}
+
+	RemoveComponent[LabelComponent](w, idb)
+	w.Despawn(ida)
+	if _, ok := w.Lookup(a); ok {
+		t.Error("a still has an entity after despawn")
+	}
+	if GetComponent[ThrusterComponent](w, ida) != nil {
+		t.Error("the components are not removed by despawn")
+	}
+	texts = texts[:0]
+	Each(w, func(_ EntityID, _ PhysicsObject, c *LabelComponent) {
+		texts = append(texts, c.Text)
+	})
+	if want := []string{"n"}; !reflect.DeepEqual(texts, want) {
+		t.Errorf("iterated labels %v after removal, expected %v", texts, want)
+	}
+}
+
+func TestWorldInfo(t *testing.T) {
+	w := NewWorld()
+	nb := NewNBodyBackend()
+	var earth PhysicsObject
+	nb.NewObject(NaturalObject, nil, mol.Vec3{}, func(o PhysicsObject) {
+		earth = o
+		w.SetInfo(o, InfoComponent{Name: "Earth", Kind: EntityPlanet})
+	})
+	moon := nb.NewObject(NaturalObject, earth, mol.Vec3{}, nil)
+	id := w.Spawn(moon)
+	AddComponent(w, id, &LabelComponent{})
+	// the info is added to the existing entity
+	w.SetInfo(moon, InfoComponent{Name: "Moon", Kind: EntityMoon})
+	if GetComponent[LabelComponent](w, id) == nil {
+		t.Fatal("SetInfo replaced the entity of the moon")
+	}
+	unnamed := nb.NewObject(NaturalObject, nil, mol.Vec3{}, nil)
+
+	if got := w.Find("Moon"); got != moon {
+		t.Errorf("found %v, expected the moon", got)
+	}
+	if got := w.Name(unnamed); got != unnamed.Id() {
+		t.Errorf("name of the unnamed object is %q, expected its id", got)
+	}
+	if want := []string{"Earth", "Moon"}; !reflect.DeepEqual(w.Names(), want) {
+		t.Errorf("names are %v, expected %v", w.Names(), want)
+	}
+	w.Despawn(id)
+	if w.Find("Moon") != nil || w.Name(moon) != moon.Id() {
+		t.Error("the name is kept after despawn")
+	}
+}
+
+func TestWorldSystemOrder(t *testing.T) {
+	w := NewWorld()
+	var order []string
+	add := func(name string, priority int) {
+		w.AddSystem(name, priority, SystemFunc(func(time.Duration) {
+			order = append(order, name)
+		}))
+	}
+	add("hud", PriorityHUD)
+	add("lod", PriorityLOD)
+	add("input", PriorityInput)
+	add("sync", PriorityPhysicsSync)
+	add("sync2", PriorityPhysicsSync)
+	add("input2", PriorityInput)
+	// replacing keeps the name unique and moves the system
+	add("hud", PriorityInput-1)
+
+	want := []string{"hud", "input", "input2", "sync", "sync2", "lod"}
+	if got := w.Systems(); !reflect.DeepEqual(got, want) {
+		t.Errorf("systems are %v, expected %v", got, want)
+	}
+	w.Update(time.Millisecond)
+	if !reflect.DeepEqual(order, want) {
+		t.Errorf("systems ran in %v, expected %v", order, want)
+	}
+	if !w.RemoveSystem("lod") || w.RemoveSystem("lod") {
+		t.Error("RemoveSystem reported wrong existence")
+	}
+}
+
+func TestThrusterComponent(t *testing.T) {
+	nb := NewNBodyBackend()
+	block := &changeBlock{}
+	o := nb.NewObject(LivingObject, nil, mol.Vec3{}, func(o PhysicsObject) {
+		o.AddBlock(block)
+	})
+	w := NewWorld()
+	thruster := &ThrusterComponent{Changes: &block.ChangeQueue}
+	AddComponent(w, w.Spawn(o), thruster)
+	w.AddSystem("thrusters", PriorityInput, SystemFunc(func(dt time.Duration) {
+		Each(w, func(_ EntityID, _ PhysicsObject, t *ThrusterComponent) {
+			t.flush()
+		})
+	}))
+	thruster.Push(mol.Vec3{1, 0, 0})
+	thruster.Push(mol.Vec3{0, 2, 0})
+	nb.Tick(time.Second)
+	if got := o.VelocityLocked(); got != (mol.Vec3{}) {
+		t.Errorf("velocity is %v before the thrusters system runs", got)
+	}
+	// the pushes are queued once
+	w.Update(time.Second)
+	w.Update(time.Second)
+	nb.Tick(time.Second)
+	nb.Tick(time.Second)
+	if got, want := o.VelocityLocked(), (mol.Vec3{1, 2, 0}); got != want {
+		t.Errorf("velocity is %v, expected %v", got, want)
+	}
+}
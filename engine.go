@@ -3,7 +3,6 @@ package main
 import (
 	"math"
 	"sync/atomic"
-	"time"
 
 	mol "github.com/LiterMC/molecular"
 	// "github.com/g3n/engine/core"
@@ -18,42 +17,51 @@ var (
 	sunRad    = 6.9634e8
 	earthRad  = 6.371e6
 	moonRad   = 1.7374e6
-	sunMass   = 3.955e30
+	sunMass   = 1.989e30
 	earthMass = 5.972e24
 	moonMass  = 7.34767309e22
+	earthDis  = 1.496e11 // the distance between the earth and the sun
+	moonSpeed = 1.022e3  // the moon's speed around the earth
 )
 
 const posScale = 1 << 23
 
+// lodRebuilds counts the sphere geometries which are generated for the planets' LOD
+var lodRebuilds atomic.Int64
+
 type PlanetBlock struct {
-	object  atomic.Pointer[mol.Object]
+	object  atomic.Value // PhysicsObject
 	mass    float64
 	radius  float64
 	outline *mol.Cube
 	lastDis float64
-	lastN   int
+	lastN   int // segments of the current geometry
+	wantN   int // segments of the requested geometry
 
 	// render
-	mat     material.IMaterial
-	geo     *geometry.Geometry
-	Node    *graphic.Mesh
+	cache *GeometryCache
+	mat   material.IMaterial
+	geo   *lodGeometry
+	Node  *graphic.Mesh
 }
 
-var _ mol.Block = (*PlanetBlock)(nil)
+var _ Block = (*PlanetBlock)(nil)
 
-func NewPlanetBlock(mass float64, radius float64, mat material.IMaterial) *PlanetBlock {
+func NewPlanetBlock(cache *GeometryCache, mass float64, radius float64, mat material.IMaterial) *PlanetBlock {
 	return &PlanetBlock{
 		mass:    mass,
 		radius:  radius,
 		outline: mol.NewCubeFromCenter(mol.Vec3{radius * 2, radius * 2, radius * 2}),
-		mat: mat,
+		cache:   cache,
+		mat:     mat,
 	}
 }
 
+// InitNode requests the geometry of the LOD for the distance, the geometry is replaced when it's generated
 func (b *PlanetBlock) InitNode(dist float64) {
-	var n int = 16
+	var n int = minLODSegments
 	if dist < b.radius {
-		n = 512
+		n = maxLODSegments
 	} else {
 		const (
 			camNear = 0.01
@@ -61,30 +69,46 @@ func (b *PlanetBlock) InitNode(dist float64) {
 		)
 		camConst := camNear / math.Tan(camFOV/2/180*math.Pi)
 		n = int(math.Sqrt(2*math.Pi*b.radius)*b.radius/dist*camConst + 8.5)
-		if n > 512 {
-			n = 512
+		if n > maxLODSegments {
+			n = maxLODSegments
 		}
 	}
 
-	if b.lastN == n {
-		return
-	}
-	b.lastN = n
-
-	if b.geo == nil {
-		b.geo = new(geometry.Geometry)
-	}
-	*b.geo = *geometry.NewSphere(b.radius/posScale, n, n)
 	if b.Node == nil {
+		// the lowest LOD is cheap enough to be generated now
+		b.geo = &lodGeometry{cache: b.cache}
+		b.geo.set(b.cache.Get(minLODSegments))
+		b.lastN, b.wantN = minLODSegments, minLODSegments
+		// the cached geometries are unit spheres
+		scale := float32(b.radius / posScale)
 		b.Node = graphic.NewMesh(b.geo, b.mat)
-		b.Node.Add(helper.NewAxes(float32(b.radius) / posScale * 2))
+		b.Node.SetScale(scale, scale, scale)
+		b.Node.Add(helper.NewAxes(2))
 	}
+	if b.wantN == n {
+		return
+	}
+	b.wantN = n
+	b.cache.Acquire(n, func(geo *geometry.Geometry) {
+		if b.wantN != n {
+			// another LOD is requested before this one is ready
+			b.cache.Release(geo)
+			return
+		}
+		b.lastN = n
+		b.geo.set(geo)
+	})
 }
 
-func (b *PlanetBlock) SetObject(o *mol.Object) {
+func (b *PlanetBlock) SetObject(o PhysicsObject) {
 	b.object.Store(o)
 }
 
+func (b *PlanetBlock) Object() PhysicsObject {
+	o, _ := b.object.Load().(PhysicsObject)
+	return o
+}
+
 func (b *PlanetBlock) Mass() float64 {
 	return b.mass
 }
@@ -100,30 +124,18 @@ func (b *PlanetBlock) Outline() *mol.Cube {
 func (b *PlanetBlock) Tick(dt float64) {
 }
 
-func (b *PlanetBlock) renderTick(r *Runner, dt time.Duration) {
-	cPos := r.cam.Position()
-	camPos := ToMolVec3(&cPos)
-	camPos.ScaleN(posScale)
-
-	obj := b.object.Load()
-	pos := obj.AbsPosLocked()
-	diff := pos.Subbed(camPos)
-	dist := diff.Len()
-	if b.lastDis == 0 {
-		b.InitNode(dist)
-	} else if d := dist - b.lastDis; d < -50 || 100 < d {
+// updateLOD requests another LOD once the distance has changed enough
+func (b *PlanetBlock) updateLOD(dist float64) {
+	if d := dist - b.lastDis; b.lastDis == 0 || d < -50 || 100 < d {
 		b.InitNode(dist)
 		b.lastDis = dist
-		return
 	}
-	pos.ScaleN(1. / posScale)
-	b.Node.SetPosition(float32(pos.X), float32(pos.Y), float32(pos.Z))
 }
 
-func InitPlanet(p *mol.Object, mass float64, radius float64, mat material.IMaterial, dist float64) (b *PlanetBlock) {
+func InitPlanet(cache *GeometryCache, p PhysicsObject, mass float64, radius float64, mat material.IMaterial, dist float64) (b *PlanetBlock) {
 	p.SetRadius(radius)
 	p.FillGfields()
-	b = NewPlanetBlock(mass, radius, mat)
+	b = NewPlanetBlock(cache, mass, radius, mat)
 	pos := p.AbsPos()
 	pos.ScaleN(1. / posScale)
 	b.InitNode(dist)
@@ -132,9 +144,22 @@ func InitPlanet(p *mol.Object, mass float64, radius float64, mat material.IMater
 	return
 }
 
+// addPlanet adds the planet's node to the scene, and spawns its entity
+func (r *Runner) addPlanet(b *PlanetBlock) {
+	id := r.world.Spawn(b.Object())
+	AddComponent(r.world, id, &MeshComponent{Node: b.Node, LOD: b.updateLOD})
+	AddComponent(r.world, id, &LabelComponent{})
+	r.mainScene.Add(b.Node)
+}
+
 func (r *Runner) initSunMoon() {
-	sun := r.intEng.NewObject(mol.NaturalObj, nil, mol.Vec3{0, 0, 0}, func(sun *mol.Object) {
-		println("sun:", sun.String())
+	sun := r.physics.NewObject(NaturalObject, nil, mol.Vec3{0, 0, 0}, func(sun PhysicsObject) {
+		worldLog.Info("object created", "name", "Sun", "object", sun.String())
+		r.world.SetInfo(sun, InfoComponent{
+			Name:        "Sun",
+			Kind:        EntityStar,
+			Description: "The star at the center of the solar system",
+		})
 		sun.SetVelocity(mol.Vec3{0, 1, 0})
 		sunColor := &math32.Color{1.0, 0.5, 0.2}
 		sunMat := material.NewStandard(sunColor)
@@ -143,29 +168,43 @@ func (r *Runner) initSunMoon() {
 		// 	SetMetallicFactor(0).
 		// 	SetRoughnessFactor(1).
 		// 	SetEmissiveFactor(sunColor)
-		sunNode := InitPlanet(sun, sunMass, sunRad, sunMat,
-			r.playerObj.AbsPos().Subbed(sun.AbsPos()).Len()).Node
-		r.mainScene.Add(sunNode)
+		r.addPlanet(InitPlanet(r.geometries, sun, sunMass, sunRad, sunMat,
+			r.playerObj.AbsPos().Subbed(sun.AbsPos()).Len()))
 	})
 
-	earth := r.intEng.NewObject(mol.NaturalObj, sun, mol.Vec3{-1.496e11, 0, 0}, func(earth *mol.Object) {
-		println("earth:", earth.String())
-		earth.SetVelocity(mol.Vec3{0, 0, 2.97222e4})
+	earth := r.physics.NewObject(NaturalObject, sun, mol.Vec3{-earthDis, 0, 0}, func(earth PhysicsObject) {
+		worldLog.Info("object created", "name", "Earth", "object", earth.String())
+		r.world.SetInfo(earth, InfoComponent{
+			Name:        "Earth",
+			Kind:        EntityPlanet,
+			Description: "The third planet from the sun",
+		})
+		// the earth-moon barycenter moves at the circular speed around the sun,
+		// the moon starts moving backward so the earth is a bit faster
+		speed := math.Sqrt(gravConst*(sunMass+earthMass+moonMass)/earthDis) + moonSpeed*moonMass/(earthMass+moonMass)
+		earth.SetVelocity(mol.Vec3{0, 0, speed})
 		earthColor := &math32.Color{0.0, 0.0, 1.0}
 		earthMat := material.NewStandard(earthColor)
-		r.earth = InitPlanet(earth, earthMass, earthRad, earthMat,
-			r.playerObj.AbsPos().Subbed(earth.AbsPos()).Len()).Node
-		r.mainScene.Add(r.earth)
+		b := InitPlanet(r.geometries, earth, earthMass, earthRad, earthMat,
+			r.playerObj.AbsPos().Subbed(earth.AbsPos()).Len())
+		r.earth = b.Node
+		r.addPlanet(b)
+		// the player starts above the earth, the backend is locked here so the player can be moved
+		r.playerObj.AttachTo(earth)
+		r.playerObj.SetPos(mol.Vec3{-earthRad, earthRad + 1e7, 0})
+		r.playerObj.SetVelocity(mol.Vec3{0, 0, 0})
 	})
-	r.playerObj.AttachTo(earth)
-	r.playerObj.SetPos(mol.Vec3{-earthRad, earthRad + 1e7, 0})
-	r.playerObj.SetVelocity(mol.Vec3{0, 0, 0})
-
-	r.intEng.NewObject(mol.NaturalObj, earth, mol.Vec3{-3e8, 1e7, 0}, func(moon *mol.Object) {
-		println("moon:", moon.String())
-		moon.SetVelocity(mol.Vec3{0, 0, -1.022e3})
-		r.mainScene.Add(InitPlanet(moon, moonMass, moonRad,
+
+	r.physics.NewObject(NaturalObject, earth, mol.Vec3{-3e8, 1e7, 0}, func(moon PhysicsObject) {
+		worldLog.Info("object created", "name", "Moon", "object", moon.String())
+		r.world.SetInfo(moon, InfoComponent{
+			Name:        "Moon",
+			Kind:        EntityMoon,
+			Description: "Earth's only natural satellite",
+		})
+		moon.SetVelocity(mol.Vec3{0, 0, -moonSpeed})
+		r.addPlanet(InitPlanet(r.geometries, moon, moonMass, moonRad,
 			material.NewStandard(&math32.Color{0.6, 0.6, 0.6}),
-			r.playerObj.AbsPos().Subbed(moon.AbsPos()).Len()).Node)
+			r.playerObj.AbsPos().Subbed(moon.AbsPos()).Len()))
 	})
 }
@@ -0,0 +1,72 @@
+package main
+
+import "sort"
+
+type EntityKind int
+
+const (
+	EntityUnknown EntityKind = iota
+	EntityStar
+	EntityPlanet
+	EntityMoon
+	EntityShip
+)
+
+func (k EntityKind) String() string {
+	switch k {
+	case EntityStar:
+		return "star"
+	case EntityPlanet:
+		return "planet"
+	case EntityMoon:
+		return "moon"
+	case EntityShip:
+		return "ship"
+	}
+	return "unknown"
+}
+
+// InfoComponent is the human readable information of an entity
+type InfoComponent struct {
+	Name        string
+	Kind        EntityKind
+	Description string
+}
+
+// SetInfo spawns the object's entity if it doesn't have one, and sets its information.
+// It's safe to call inside the engine's object callbacks.
+func (w *World) SetInfo(o PhysicsObject, info InfoComponent) {
+	AddComponent(w, w.Spawn(o), &info)
+}
+
+// Name returns the entity's name, or the object's id if it doesn't have a name
+func (w *World) Name(o PhysicsObject) string {
+	if o == nil {
+		return "<none>"
+	}
+	if c := ObjectComponent[InfoComponent](w, o); c != nil && c.Name != "" {
+		return c.Name
+	}
+	return o.Id()
+}
+
+// Names returns the names of all entities which have an object
+func (w *World) Names() (names []string) {
+	Each(w, func(_ EntityID, o PhysicsObject, c *InfoComponent) {
+		if o != nil && c.Name != "" {
+			names = append(names, c.Name)
+		}
+	})
+	sort.Strings(names)
+	return
+}
+
+// Find returns the object of the first entity which has the name
+func (w *World) Find(name string) (found PhysicsObject) {
+	Each(w, func(_ EntityID, o PhysicsObject, c *InfoComponent) {
+		if found == nil && c.Name == name {
+			found = o
+		}
+	})
+	return
+}
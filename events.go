@@ -0,0 +1,225 @@
+package main
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	mol "github.com/LiterMC/molecular"
+	"github.com/g3n/engine/core"
+)
+
+// The simulation events which are dispatched by the EventBus
+const (
+	OnAnchorChange   = "sim.AnchorChange"   // *AnchorChangeEvent
+	OnCollision      = "sim.Collision"      // *CollisionEvent
+	OnObjectCreate   = "sim.ObjectCreate"   // *ObjectEvent
+	OnObjectDestroy  = "sim.ObjectDestroy"  // *ObjectEvent
+	OnTimeWarpChange = "sim.TimeWarpChange" // *TimeWarpEvent
+)
+
+// AnchorChangeEvent is sent when an object enters another sphere of influence
+type AnchorChangeEvent struct {
+	Object   PhysicsObject
+	Old, New PhysicsObject
+}
+
+// CollisionEvent is sent when two objects start touching each other
+type CollisionEvent struct {
+	A, B  PhysicsObject
+	Speed float64 // the relative speed in m/s
+}
+
+type ObjectEvent struct {
+	Object PhysicsObject
+}
+
+type TimeWarpEvent struct {
+	Old, New float64
+}
+
+type queuedEvent struct {
+	time   time.Time
+	evname string
+	ev     any
+}
+
+// EventBus queues the events from any goroutine, and dispatches them on the render thread.
+// The subscriptions must be made on the render thread.
+type EventBus struct {
+	core.Dispatcher
+
+	mux   sync.Mutex
+	queue []queuedEvent
+}
+
+func NewEventBus() (b *EventBus) {
+	b = new(EventBus)
+	b.Dispatcher.Initialize()
+	return
+}
+
+// Publish queues the event, it will be dispatched by the next Flush
+func (b *EventBus) Publish(evname string, ev any) {
+	b.PublishAt(time.Time{}, evname, ev)
+}
+
+// PublishAt queues the event which happens at the wall time t,
+// it's not dispatched until the render loop reaches the time
+func (b *EventBus) PublishAt(t time.Time, evname string, ev any) {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+	b.queue = append(b.queue, queuedEvent{t, evname, ev})
+}
+
+// Flush dispatches the events which happen before or at t, in the order they are published
+func (b *EventBus) Flush(t time.Time) (n int) {
+	b.mux.Lock()
+	var ready []queuedEvent
+	rest := b.queue[:0]
+	for _, e := range b.queue {
+		if e.time.After(t) {
+			rest = append(rest, e)
+		} else {
+			ready = append(ready, e)
+		}
+	}
+	clear(b.queue[len(rest):])
+	b.queue = rest
+	b.mux.Unlock()
+
+	for _, e := range ready {
+		b.Dispatch(e.evname, e.ev)
+	}
+	return len(ready)
+}
+
+// simWatcher compares the snapshots on the physics goroutine, and publishes the changes as events.
+// The objects which exist before the first snapshot are not reported as created.
+type simWatcher struct {
+	bus      *EventBus
+	prev     *Snapshot
+	touching map[[2]PhysicsObject]bool
+}
+
+func newSimWatcher(bus *EventBus) *simWatcher {
+	return &simWatcher{
+		bus:      bus,
+		touching: make(map[[2]PhysicsObject]bool),
+	}
+}
+
+// objectRadii returns the radii of the objects which have a surface
+func objectRadii(eng PhysicsBackend) map[PhysicsObject]float64 {
+	radii := make(map[PhysicsObject]float64)
+	eng.ForeachBlock(func(b Block) {
+		if pb, ok := b.(*PlanetBlock); ok {
+			if o := pb.Object(); o != nil && pb.radius > radii[o] {
+				radii[o] = pb.radius
+			}
+		}
+	})
+	return radii
+}
+
+// tick is called by the physics goroutine after the snapshot is taken
+func (w *simWatcher) tick(s *Snapshot, radii map[PhysicsObject]float64) {
+	objects := make([]PhysicsObject, 0, len(s.Objects))
+	for o := range s.Objects {
+		objects = append(objects, o)
+	}
+	// the events of the same tick are published in a stable order
+	sort.Slice(objects, func(i, j int) bool { return objects[i].Id() < objects[j].Id() })
+
+	if w.prev != nil {
+		for _, o := range objects {
+			st := s.Objects[o]
+			if old, ok := w.prev.Objects[o]; !ok {
+				w.bus.PublishAt(s.Time, OnObjectCreate, &ObjectEvent{Object: o})
+			} else if old.Anchor != st.Anchor {
+				w.bus.PublishAt(s.Time, OnAnchorChange, &AnchorChangeEvent{Object: o, Old: old.Anchor, New: st.Anchor})
+			}
+		}
+		var destroyed []PhysicsObject
+		for o := range w.prev.Objects {
+			if _, ok := s.Objects[o]; !ok {
+				destroyed = append(destroyed, o)
+			}
+		}
+		sort.Slice(destroyed, func(i, j int) bool { return destroyed[i].Id() < destroyed[j].Id() })
+		for _, o := range destroyed {
+			w.bus.PublishAt(s.Time, OnObjectDestroy, &ObjectEvent{Object: o})
+		}
+	}
+
+	touching := make(map[[2]PhysicsObject]bool, len(w.touching))
+	for i, a := range objects {
+		for _, b := range objects[i+1:] {
+			ra, rb := radii[a], radii[b]
+			if ra == 0 && rb == 0 {
+				continue
+			}
+			sa, sb := s.Objects[a], s.Objects[b]
+			if sb.AbsPos.Subbed(sa.AbsPos).Len() >= ra+rb {
+				continue
+			}
+			key := [2]PhysicsObject{a, b}
+			touching[key] = true
+			if !w.touching[key] {
+				speed := absStateVelocity(s, b).Subbed(absStateVelocity(s, a)).Len()
+				w.bus.PublishAt(s.Time, OnCollision, &CollisionEvent{A: a, B: b, Speed: speed})
+			}
+		}
+	}
+	w.touching = touching
+	w.prev = s
+}
+
+// absStateVelocity sums the velocities of the object and its anchors in the snapshot
+func absStateVelocity(s *Snapshot, o PhysicsObject) (vel mol.Vec3) {
+	for o != nil {
+		st, ok := s.Objects[o]
+		if !ok {
+			st = liveState(o)
+		}
+		vel.Add(st.Vel)
+		o = st.Anchor
+	}
+	return
+}
+
+// initEventLogging logs the simulation events, and removes the entities and meshes of the destroyed objects
+func (r *Runner) initEventLogging() {
+	bus := r.events
+	bus.SubscribeID(OnAnchorChange, r, func(evname string, ev any) {
+		e := ev.(*AnchorChangeEvent)
+		worldLog.Info("anchor changed", "object", r.world.Name(e.Object),
+			"from", r.world.Name(e.Old), "to", r.world.Name(e.New))
+	})
+	bus.SubscribeID(OnCollision, r, func(evname string, ev any) {
+		e := ev.(*CollisionEvent)
+		worldLog.Info("collision", "a", r.world.Name(e.A), "b", r.world.Name(e.B), "speed", e.Speed)
+	})
+	bus.SubscribeID(OnObjectCreate, r, func(evname string, ev any) {
+		worldLog.Debug("object created", "object", r.world.Name(ev.(*ObjectEvent).Object))
+	})
+	bus.SubscribeID(OnObjectDestroy, r, func(evname string, ev any) {
+		o := ev.(*ObjectEvent).Object
+		worldLog.Info("object destroyed", "object", r.world.Name(o))
+		id, ok := r.world.Lookup(o)
+		if !ok {
+			return
+		}
+		if mesh := GetComponent[MeshComponent](r.world, id); mesh != nil {
+			// the mesh's geometry is released to the geometry cache by Dispose
+			r.mainScene.Remove(mesh.Node)
+			mesh.Node.GetNode().DisposeChildren(true)
+			mesh.Node.Dispose()
+		}
+		r.world.Despawn(id)
+	})
+	bus.SubscribeID(OnTimeWarpChange, r, func(evname string, ev any) {
+		e := ev.(*TimeWarpEvent)
+		coreLog.Debug("time warp changed", "old", e.Old, "new", e.New)
+	})
+}
@@ -0,0 +1,177 @@
+package main
+
+import (
+	"reflect"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	mol "github.com/LiterMC/molecular"
+	"github.com/g3n/engine/graphic"
+)
+
+func TestEventBusFlush(t *testing.T) {
+	bus := NewEventBus()
+	var got []float64
+	bus.SubscribeID(OnTimeWarpChange, t, func(evname string, ev any) {
+		got = append(got, ev.(*TimeWarpEvent).New)
+	})
+
+	base := time.Now()
+	bus.PublishAt(base.Add(time.Second), OnTimeWarpChange, &TimeWarpEvent{New: 3})
+	bus.PublishAt(base, OnTimeWarpChange, &TimeWarpEvent{New: 1})
+	bus.Publish(OnTimeWarpChange, &TimeWarpEvent{New: 2})
+
+	if n := bus.Flush(base); n != 2 {
+		t.Fatalf("flushed %d events, expected 2", n)
+	}
+	if want := []float64{1, 2}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("received %v, expected %v", got, want)
+	}
+	if n := bus.Flush(base.Add(time.Second)); n != 1 || got[len(got)-1] != 3 {
+		t.Fatalf("the delayed event is not delivered after its time")
+	}
+	if n := bus.Flush(base.Add(time.Hour)); n != 0 {
+		t.Fatalf("flushed %d events again", n)
+	}
+}
+
+func TestEventBusConcurrentPublish(t *testing.T) {
+	const (
+		publishers = 8
+		count      = 1000
+	)
+	bus := NewEventBus()
+	received := 0
+	bus.SubscribeID(OnObjectCreate, t, func(evname string, ev any) {
+		received++
+	})
+	var wg sync.WaitGroup
+	for i := 0; i < publishers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < count; j++ {
+				bus.Publish(OnObjectCreate, &ObjectEvent{})
+			}
+		}()
+	}
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	for flushing := true; flushing; {
+		select {
+		case <-done:
+			flushing = false
+		default:
+			// lets the publishers run on a single thread, like js/wasm
+			runtime.Gosched()
+		}
+		bus.Flush(time.Now())
+	}
+	if received != publishers*count {
+		t.Errorf("received %d events, expected %d", received, publishers*count)
+	}
+}
+
+// recordEvents collects the events of the bus by their names
+func recordEvents(bus *EventBus) *[]string {
+	var names []string
+	for _, evname := range []string{OnAnchorChange, OnCollision, OnObjectCreate, OnObjectDestroy} {
+		bus.SubscribeID(evname, &names, func(evname string, ev any) {
+			names = append(names, evname)
+		})
+	}
+	return &names
+}
+
+func TestSimWatcher(t *testing.T) {
+	const radius = 1e3
+	nb := NewNBodyBackend()
+	bus := NewEventBus()
+	w := newSimWatcher(bus)
+	names := recordEvents(bus)
+
+	planet := nb.NewObject(NaturalObject, nil, mol.Vec3{}, nil)
+	ctl := new(changeBlock)
+	nb.NewObject(LivingObject, nil, mol.Vec3{2 * radius, 0, 0}, func(o PhysicsObject) {
+		o.AddBlock(ctl)
+	})
+	radii := map[PhysicsObject]float64{planet: radius}
+	now := time.Now()
+	step := func() {
+		now = now.Add(physicsTickInterval)
+		nb.Tick(physicsTickInterval)
+		w.tick(takeSnapshot(nb, now, 0), radii)
+		bus.Flush(now)
+	}
+
+	step()
+	if len(*names) != 0 {
+		t.Fatalf("the first snapshot sent %v", *names)
+	}
+
+	ctl.Do(func(ship PhysicsObject) {
+		ship.AttachTo(planet)
+		ship.SetPos(mol.Vec3{radius / 2, 0, 0})
+		ship.SetVelocity(mol.Vec3{0, 10, 0})
+	})
+	step()
+	if want := []string{OnAnchorChange, OnCollision}; !reflect.DeepEqual(*names, want) {
+		t.Fatalf("sent %v, expected %v", *names, want)
+	}
+
+	// staying in contact is not another collision
+	*names = (*names)[:0]
+	step()
+	moon := nb.NewObject(NaturalObject, planet, mol.Vec3{1e9, 0, 0}, nil)
+	step()
+	if want := []string{OnObjectCreate}; !reflect.DeepEqual(*names, want) {
+		t.Fatalf("sent %v, expected %v", *names, want)
+	}
+
+	// an object which is removed from the backend
+	*names = (*names)[:0]
+	now = now.Add(physicsTickInterval)
+	s := takeSnapshot(nb, now, 0)
+	delete(s.Objects, moon)
+	w.tick(s, radii)
+	bus.Flush(now)
+	if want := []string{OnObjectDestroy}; !reflect.DeepEqual(*names, want) {
+		t.Fatalf("sent %v, expected %v", *names, want)
+	}
+}
+
+func TestObjectDestroyRemovesMesh(t *testing.T) {
+	r := newHeadlessRunner(t, newTestNBody(IntegratorLeapfrog))
+	r.events = NewEventBus()
+	r.initEventLogging()
+	moon := r.world.Find("Moon")
+	id, ok := r.world.Lookup(moon)
+	if !ok {
+		t.Fatal("moon has no entity")
+	}
+	mesh := GetComponent[MeshComponent](r.world, id)
+	if mesh == nil {
+		t.Fatal("moon has no mesh")
+	}
+	node := mesh.Node.(*graphic.Mesh)
+
+	now := time.Now()
+	r.events.PublishAt(now, OnObjectDestroy, &ObjectEvent{Object: moon})
+	r.events.Flush(now)
+	if _, ok := r.world.Lookup(moon); ok {
+		t.Error("moon still has an entity")
+	}
+	for _, child := range r.mainScene.GetNode().Children() {
+		if child == node {
+			t.Fatal("moon's mesh is still in the scene")
+		}
+	}
+	if node.GetGeometry() != nil {
+		t.Error("moon's geometry is not released")
+	}
+}
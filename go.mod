@@ -2,11 +2,16 @@ module github.com/LiterMC/curve
 
 go 1.21.1
 
-require github.com/g3n/engine v0.2.1-0.20231210143125-4e30d5c3f79e
+require (
+	github.com/g3n/engine v0.2.1-0.20231210143125-4e30d5c3f79e
+	github.com/go-gl/glfw/v3.3/glfw v0.0.0-20210410170116-ea3d685f79fb
+)
 
 require (
-	github.com/go-gl/glfw/v3.3/glfw v0.0.0-20210410170116-ea3d685f79fb // indirect
 	github.com/golang/freetype v0.0.0-20170609003504-e2365dfdc4a0 // indirect
 	golang.org/x/image v0.0.0-20210607152325-775e3b0c77b9 // indirect
 	gopkg.in/yaml.v2 v2.4.0 // indirect
 )
+
+// the fork adds the WebGL framebuffer calls of the renderer, see README.MD
+replace github.com/g3n/engine => ./third_party/g3n
@@ -0,0 +1,354 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/g3n/engine/gui"
+	"github.com/g3n/engine/math32"
+	"github.com/g3n/engine/window"
+)
+
+// HUDLayout is the persisted order and visibility of the HUD widgets
+type HUDLayout struct {
+	Order  []string `json:"order,omitempty"`
+	Hidden []string `json:"hidden,omitempty"`
+}
+
+// HUDValueFunc returns the text of a widget, the widget is skipped when ok is false
+type HUDValueFunc = func() (text string, ok bool)
+
+// HUDWidget is a readout row of the HUD
+type HUDWidget struct {
+	ID    string
+	Title string
+	Value HUDValueFunc
+
+	visible bool
+	title   *gui.Label
+	value   *gui.Label
+}
+
+func (w *HUDWidget) Visible() bool {
+	return w.visible
+}
+
+// HUD shows the registered widgets as rows in a panel at the top left corner.
+// The widgets can be shown, hidden and reordered with the editor.
+type HUD struct {
+	r       *Runner
+	panel   *gui.Panel
+	widgets []*HUDWidget
+	index   map[string]*HUDWidget
+	rank    map[string]int
+	hidden  map[string]bool
+	scale   float32
+	enabled bool
+
+	editor      *gui.Panel
+	editorDirty bool
+
+	// configs
+	FontSize float64 // unscaled font size
+	Spacing  float32 // unscaled pixels between the title and the value
+
+	// hooks
+	OnChange func(layout HUDLayout)
+}
+
+func NewHUD(r *Runner, layout HUDLayout) (h *HUD) {
+	h = new(HUD)
+	h.r = r
+	h.index = make(map[string]*HUDWidget)
+	h.scale = 1
+	h.enabled = true
+	h.FontSize = gui.StyleDefault().Label.PointSize
+	h.Spacing = 5
+
+	h.panel = gui.NewPanel(0, 0)
+	h.panel.SetPaddings(5, 5, 5, 5)
+	h.panel.SetColor4(&math32.Color4{0.7, 0.7, 0.7, 0.5})
+
+	h.editor = gui.NewPanel(0, 0)
+	h.editor.SetPaddings(5, 5, 5, 5)
+	h.editor.SetColor4(&math32.Color4{0.2, 0.2, 0.2, 0.8})
+	h.editor.SetVisible(false)
+
+	h.SetLayout(layout)
+	gui.Manager().SubscribeID(window.OnKeyDown, &h, h.onKey)
+	return
+}
+
+func (h *HUD) Dispose() {
+	gui.Manager().UnsubscribeID(window.OnKeyDown, &h)
+}
+
+// Panel returns the gui panel of the widgets
+func (h *HUD) Panel() gui.IPanel {
+	return h.panel
+}
+
+// Editor returns the gui panel which edits the widgets
+func (h *HUD) Editor() gui.IPanel {
+	return h.editor
+}
+
+func (h *HUD) Enabled() bool {
+	return h.enabled
+}
+
+func (h *HUD) SetEnabled(enabled bool) {
+	h.enabled = enabled
+	h.panel.SetVisible(enabled)
+}
+
+// Register adds a widget, it's placed by the saved layout if the id is known,
+// otherwise it's appended to the end.
+// It returns an error if the id is already registered.
+func (h *HUD) Register(id string, title string, value HUDValueFunc) error {
+	if _, ok := h.index[id]; ok {
+		return fmt.Errorf("hud widget %q is already registered", id)
+	}
+	w := &HUDWidget{
+		ID:      id,
+		Title:   title,
+		Value:   value,
+		visible: !h.hidden[id],
+		title:   gui.NewLabel(title),
+		value:   gui.NewLabel(""),
+	}
+	w.title.SetFontSize(h.FontSize * (float64)(h.scale))
+	w.value.SetFontSize(h.FontSize * (float64)(h.scale))
+	h.panel.Add(w.title)
+	h.panel.Add(w.value)
+	if _, ok := h.rank[id]; !ok {
+		h.rank[id] = len(h.rank)
+	}
+	h.index[id] = w
+	h.widgets = append(h.widgets, w)
+	h.sortWidgets()
+	h.editorDirty = true
+	return nil
+}
+
+// Unregister removes the widget, it keeps its place in the layout
+func (h *HUD) Unregister(id string) {
+	w, ok := h.index[id]
+	if !ok {
+		return
+	}
+	delete(h.index, id)
+	for i, v := range h.widgets {
+		if v == w {
+			h.widgets = append(h.widgets[:i], h.widgets[i+1:]...)
+			break
+		}
+	}
+	h.panel.Remove(w.title)
+	h.panel.Remove(w.value)
+	w.title.Dispose()
+	w.value.Dispose()
+	h.editorDirty = true
+}
+
+// Widgets returns the registered widgets in the display order
+func (h *HUD) Widgets() []*HUDWidget {
+	return h.widgets
+}
+
+func (h *HUD) Get(id string) *HUDWidget {
+	return h.index[id]
+}
+
+func (h *HUD) sortWidgets() {
+	sort.SliceStable(h.widgets, func(i, j int) bool {
+		return h.rank[h.widgets[i].ID] < h.rank[h.widgets[j].ID]
+	})
+}
+
+// Layout returns the current order and visibility.
+// The saved widgets which are not registered now are kept.
+func (h *HUD) Layout() (layout HUDLayout) {
+	ids := make([]string, 0, len(h.rank))
+	for id := range h.rank {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return h.rank[ids[i]] < h.rank[ids[j]] })
+	layout.Order = ids
+	for _, id := range ids {
+		if h.hidden[id] {
+			layout.Hidden = append(layout.Hidden, id)
+		}
+	}
+	return
+}
+
+// SetLayout applies the order and visibility
+func (h *HUD) SetLayout(layout HUDLayout) {
+	h.rank = make(map[string]int, len(layout.Order))
+	for _, id := range layout.Order {
+		if _, ok := h.rank[id]; !ok {
+			h.rank[id] = len(h.rank)
+		}
+	}
+	h.hidden = make(map[string]bool, len(layout.Hidden))
+	for _, id := range layout.Hidden {
+		h.hidden[id] = true
+	}
+	for _, w := range h.widgets {
+		if _, ok := h.rank[w.ID]; !ok {
+			h.rank[w.ID] = len(h.rank)
+		}
+		w.visible = !h.hidden[w.ID]
+	}
+	h.sortWidgets()
+	h.editorDirty = true
+}
+
+// Show shows or hides the widget
+func (h *HUD) Show(id string, visible bool) {
+	w, ok := h.index[id]
+	if !ok || w.visible == visible {
+		return
+	}
+	w.visible = visible
+	if visible {
+		delete(h.hidden, id)
+	} else {
+		h.hidden[id] = true
+	}
+	h.changed()
+}
+
+// Move moves the widget by delta rows
+func (h *HUD) Move(id string, delta int) {
+	i := -1
+	for j, w := range h.widgets {
+		if w.ID == id {
+			i = j
+			break
+		}
+	}
+	j := i + delta
+	if i < 0 || j < 0 || j >= len(h.widgets) || delta == 0 {
+		return
+	}
+	a, b := h.widgets[i].ID, h.widgets[j].ID
+	h.rank[a], h.rank[b] = h.rank[b], h.rank[a]
+	h.sortWidgets()
+	h.editorDirty = true
+	h.changed()
+}
+
+func (h *HUD) changed() {
+	if h.OnChange != nil {
+		h.OnChange(h.Layout())
+	}
+}
+
+// SetScale sets the UI scale of the widgets
+func (h *HUD) SetScale(scale float32) {
+	h.scale = scale
+	for _, w := range h.widgets {
+		w.title.SetFontSize(h.FontSize * (float64)(scale))
+		w.value.SetFontSize(h.FontSize * (float64)(scale))
+	}
+	h.editorDirty = true
+}
+
+func (h *HUD) EditorOpened() bool {
+	return h.editor.Visible()
+}
+
+// OpenEditor shows the editor and releases the cursor
+func (h *HUD) OpenEditor() {
+	h.r.player.ctrl.Pause()
+	h.editor.SetVisible(true)
+	h.editorDirty = true
+}
+
+func (h *HUD) CloseEditor() {
+	h.editor.SetVisible(false)
+}
+
+// rebuildEditor recreates the editor's rows, it's called in update
+// so the rows are not disposed while their events are dispatching
+func (h *HUD) rebuildEditor() {
+	h.editorDirty = false
+	h.editor.DisposeChildren(true)
+
+	const rowHeight = 26
+	buttonsX := 200 * h.scale
+	title := gui.NewLabel("HUD widgets (H to close)")
+	h.editor.Add(title)
+	width := title.Width()
+	var y float32 = rowHeight
+	for _, w := range h.widgets {
+		id := w.ID
+		cb := gui.NewCheckBox(w.Title)
+		cb.SetValue(w.visible)
+		cb.SetPosition(0, y)
+		cb.Subscribe(gui.OnChange, func(evname string, ev any) {
+			h.Show(id, cb.Value())
+		})
+		h.editor.Add(cb)
+
+		up := gui.NewButton("Up")
+		up.Subscribe(gui.OnClick, func(evname string, ev any) {
+			h.Move(id, -1)
+		})
+		down := gui.NewButton("Down")
+		down.Subscribe(gui.OnClick, func(evname string, ev any) {
+			h.Move(id, 1)
+		})
+		up.SetPosition(buttonsX, y)
+		h.editor.Add(up)
+		down.SetPosition(buttonsX+up.Width()+4, y)
+		h.editor.Add(down)
+
+		width = math32.Max(width, down.Position().X+down.Width())
+		y += rowHeight
+	}
+	h.editor.SetContentSize(width, y)
+}
+
+func (h *HUD) update() {
+	if h.editorDirty && h.editor.Visible() {
+		h.rebuildEditor()
+	}
+	if !h.enabled {
+		return
+	}
+	var y, width float32
+	spacing := h.Spacing * h.scale
+	for _, w := range h.widgets {
+		text, ok := "", w.visible
+		if ok {
+			text, ok = w.Value()
+		}
+		w.title.SetVisible(ok)
+		w.value.SetVisible(ok)
+		if !ok {
+			continue
+		}
+		w.value.SetText(text)
+		w.title.SetPosition(0, y)
+		w.value.SetPosition(w.title.Width()+spacing, y)
+		width = math32.Max(width, w.value.Position().X+w.value.Width())
+		y += math32.Max(w.title.Height(), w.value.Height())
+	}
+	h.panel.SetVisible(y > 0)
+	h.panel.SetContentSize(width, y)
+}
+
+func (h *HUD) onKey(evname string, ev any) {
+	kev := ev.(*window.KeyEvent)
+	switch kev.Key {
+	case window.KeyH:
+		if h.EditorOpened() {
+			h.CloseEditor()
+		} else {
+			h.OpenEditor()
+		}
+	}
+}
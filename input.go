@@ -0,0 +1,20 @@
+package main
+
+import (
+	"github.com/g3n/engine/core"
+)
+
+// Input is where the controls receive their events from, and how they capture the cursor.
+// The key, mouse and scroll events come from the gui manager,
+// the focus events come from the window, and the cursor events go to the dispatcher which has the cursor focus.
+type Input interface {
+	SubscribeID(evname string, id any, cb core.Callback)
+	UnsubscribeID(evname string, id any) int
+
+	// SetCursorFocus sends the cursor events to d, nil sends them back to the gui
+	SetCursorFocus(d core.IDispatcher)
+	// CaptureCursor hides the cursor, locks it in the window and moves it to (0, 0)
+	CaptureCursor()
+	// ReleaseCursor shows the cursor, and moves it to the center of the window if center is true
+	ReleaseCursor(center bool)
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"github.com/g3n/engine/core"
+	"github.com/g3n/engine/window"
+)
+
+// fakeInput is an Input without a window, the events are scripted by the tests
+type fakeInput struct {
+	core.Dispatcher
+	cursorFocus core.IDispatcher
+
+	captured bool
+	centered bool // whether the cursor was moved to the center by the last release
+}
+
+var _ Input = (*fakeInput)(nil)
+
+func newFakeInput() (in *fakeInput) {
+	in = new(fakeInput)
+	in.Dispatcher.Initialize()
+	return
+}
+
+func (in *fakeInput) SetCursorFocus(d core.IDispatcher) {
+	in.cursorFocus = d
+}
+
+func (in *fakeInput) CaptureCursor() {
+	in.captured = true
+}
+
+func (in *fakeInput) ReleaseCursor(center bool) {
+	in.captured = false
+	in.centered = center
+}
+
+func (in *fakeInput) KeyDown(key window.Key, mods window.ModifierKey) {
+	in.Dispatch(window.OnKeyDown, &window.KeyEvent{Key: key, Mods: mods})
+}
+
+func (in *fakeInput) KeyUp(key window.Key) {
+	in.Dispatch(window.OnKeyUp, &window.KeyEvent{Key: key})
+}
+
+func (in *fakeInput) Click(button window.MouseButton) {
+	in.Dispatch(window.OnMouseDown, &window.MouseEvent{Button: button})
+	in.Dispatch(window.OnMouseUp, &window.MouseEvent{Button: button})
+}
+
+func (in *fakeInput) Scroll(yoffset float32) {
+	in.Dispatch(window.OnScroll, &window.ScrollEvent{Yoffset: yoffset})
+}
+
+// Cursor moves the cursor to (x, y), the event only goes to the dispatcher which has the cursor focus
+func (in *fakeInput) Cursor(x, y float32) {
+	if in.cursorFocus != nil {
+		in.cursorFocus.Dispatch(window.OnCursor, &window.CursorEvent{Xpos: x, Ypos: y})
+	}
+}
+
+func (in *fakeInput) Focus(focused bool) {
+	in.Dispatch(window.OnWindowFocus, &window.FocusEvent{Focused: focused})
+}
@@ -0,0 +1,53 @@
+//go:build !wasm
+
+package main
+
+import (
+	"github.com/g3n/engine/core"
+	"github.com/g3n/engine/gui"
+	"github.com/g3n/engine/window"
+	"github.com/go-gl/glfw/v3.3/glfw"
+)
+
+// glfwInput is the Input of the desktop window
+type glfwInput struct{}
+
+var _ Input = glfwInput{}
+
+func NewWindowInput() Input {
+	return glfwInput{}
+}
+
+func (glfwInput) SubscribeID(evname string, id any, cb core.Callback) {
+	if evname == window.OnWindowFocus {
+		window.Get().SubscribeID(evname, id, cb)
+		return
+	}
+	gui.Manager().SubscribeID(evname, id, cb)
+}
+
+func (glfwInput) UnsubscribeID(evname string, id any) int {
+	if evname == window.OnWindowFocus {
+		return window.Get().UnsubscribeID(evname, id)
+	}
+	return gui.Manager().UnsubscribeID(evname, id)
+}
+
+func (glfwInput) SetCursorFocus(d core.IDispatcher) {
+	gui.Manager().SetCursorFocus(d)
+}
+
+func (glfwInput) CaptureCursor() {
+	win := window.Get().(*window.GlfwWindow)
+	win.SetInputMode(glfw.CursorMode, glfw.CursorDisabled)
+	win.SetCursorPos(0, 0)
+}
+
+func (glfwInput) ReleaseCursor(center bool) {
+	win := window.Get().(*window.GlfwWindow)
+	win.SetInputMode(glfw.CursorMode, glfw.CursorNormal)
+	if center {
+		w, h := win.GetSize()
+		win.SetCursorPos((float64)(w/2), (float64)(h/2))
+	}
+}
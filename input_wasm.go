@@ -0,0 +1,139 @@
+//go:build wasm
+
+package main
+
+import (
+	"syscall/js"
+
+	"github.com/g3n/engine/core"
+	"github.com/g3n/engine/gui"
+	"github.com/g3n/engine/window"
+)
+
+// browserInput is the Input of the WebGL canvas.
+// The cursor is captured by the pointer lock, when the browser releases the lock (e.g. by Esc)
+// it's reported as the window losing the focus.
+type browserInput struct {
+	core.Dispatcher // dispatches OnWindowFocus
+
+	canvas js.Value
+	focus  core.IDispatcher
+	sink   core.Dispatcher // swallows the gui's cursor events while the cursor is focused
+	locked bool
+
+	cursorEv window.CursorEvent
+	focusEv  window.FocusEvent
+	charEv   window.CharEvent
+}
+
+var _ Input = (*browserInput)(nil)
+
+func NewWindowInput() Input {
+	b := new(browserInput)
+	b.Dispatcher.Initialize()
+	b.sink.Initialize()
+	b.canvas = window.Get().(*window.WebGlCanvas).Canvas()
+
+	// g3n's canvas does not dispatch these events, so they are listened here
+	b.canvas.Call("addEventListener", "mousemove", js.FuncOf(b.onMouseMove))
+	js.Global().Call("addEventListener", "keydown", js.FuncOf(b.onKeyDown))
+	js.Global().Call("addEventListener", "focus", js.FuncOf(func(this js.Value, args []js.Value) any {
+		b.setFocused(true)
+		return nil
+	}))
+	js.Global().Call("addEventListener", "blur", js.FuncOf(func(this js.Value, args []js.Value) any {
+		b.setFocused(false)
+		return nil
+	}))
+	document.Call("addEventListener", "pointerlockchange", js.FuncOf(b.onPointerLockChange))
+	document.Call("addEventListener", "pointerlockerror", js.FuncOf(func(this js.Value, args []js.Value) any {
+		b.setFocused(false)
+		return nil
+	}))
+	return b
+}
+
+func (b *browserInput) SubscribeID(evname string, id any, cb core.Callback) {
+	if evname == window.OnWindowFocus {
+		b.Dispatcher.SubscribeID(evname, id, cb)
+		return
+	}
+	gui.Manager().SubscribeID(evname, id, cb)
+}
+
+func (b *browserInput) UnsubscribeID(evname string, id any) int {
+	if evname == window.OnWindowFocus {
+		return b.Dispatcher.UnsubscribeID(evname, id)
+	}
+	return gui.Manager().UnsubscribeID(evname, id)
+}
+
+func (b *browserInput) SetCursorFocus(d core.IDispatcher) {
+	b.focus = d
+	if d == nil {
+		gui.Manager().SetCursorFocus(nil)
+		return
+	}
+	// the gui's cursor events only have the frozen position of the locked pointer
+	gui.Manager().SetCursorFocus(&b.sink)
+}
+
+func (b *browserInput) CaptureCursor() {
+	b.cursorEv.Xpos, b.cursorEv.Ypos = 0, 0
+	// it's called inside the mouse down handler, so the browser accepts the request
+	b.canvas.Call("requestPointerLock")
+}
+
+// ReleaseCursor exits the pointer lock, the browser does not allow to move the cursor so center is ignored
+func (b *browserInput) ReleaseCursor(center bool) {
+	b.locked = false
+	document.Call("exitPointerLock")
+}
+
+func (b *browserInput) setFocused(focused bool) {
+	b.focusEv.Focused = focused
+	b.Dispatch(window.OnWindowFocus, &b.focusEv)
+}
+
+func (b *browserInput) onPointerLockChange(this js.Value, args []js.Value) any {
+	locked := document.Get("pointerLockElement").Equal(b.canvas)
+	switch {
+	case locked && b.focus == nil:
+		// the cursor was released before the lock is granted
+		document.Call("exitPointerLock")
+		locked = false
+	case !locked && b.locked:
+		b.setFocused(false)
+	}
+	b.locked = locked
+	return nil
+}
+
+// onMouseMove accumulates the movements of the locked pointer into a virtual cursor position
+func (b *browserInput) onMouseMove(this js.Value, args []js.Value) any {
+	if !b.locked || b.focus == nil {
+		return nil
+	}
+	event := args[0]
+	b.cursorEv.Xpos += (float32)(event.Get("movementX").Float())
+	b.cursorEv.Ypos += (float32)(event.Get("movementY").Float())
+	b.focus.Dispatch(window.OnCursor, &b.cursorEv)
+	return nil
+}
+
+// onKeyDown dispatches the typed characters for the text inputs,
+// and stops the browser's shortcuts while the game has the pointer
+func (b *browserInput) onKeyDown(this js.Value, args []js.Value) any {
+	event := args[0]
+	if b.locked {
+		event.Call("preventDefault")
+	}
+	if event.Get("ctrlKey").Bool() || event.Get("metaKey").Bool() {
+		return nil
+	}
+	if key := []rune(event.Get("key").String()); len(key) == 1 {
+		b.charEv.Char = key[0]
+		window.Get().Dispatch(window.OnChar, &b.charEv)
+	}
+	return nil
+}
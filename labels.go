@@ -0,0 +1,194 @@
+package main
+
+import (
+	"math"
+	"sort"
+
+	"github.com/LiterMC/curve/units"
+	mol "github.com/LiterMC/molecular"
+	"github.com/g3n/engine/gui"
+	"github.com/g3n/engine/math32"
+	"github.com/g3n/engine/window"
+)
+
+type bodyLabel struct {
+	body  *Body
+	comp  *LabelComponent
+	label *gui.Label
+	dist  float64
+	x, y  float32
+}
+
+// BodyLabels draws the name and distance of every body at its projected position
+type BodyLabels struct {
+	r       *Runner
+	root    *gui.Panel
+	labels  []*bodyLabel
+	index   map[PhysicsObject]*bodyLabel
+	enabled bool
+
+	// configs
+	FadeNear float64 // labels are fully opaque under this distance
+	FadeFar  float64 // labels are faded to MinAlpha above this distance
+	MinAlpha float32
+	Offset   float32 // pixels between the body and its label
+}
+
+func NewBodyLabels(r *Runner) (bl *BodyLabels) {
+	bl = new(BodyLabels)
+	bl.r = r
+	bl.root = gui.NewPanel(0, 0)
+	bl.root.SetEnabled(false)
+	bl.index = make(map[PhysicsObject]*bodyLabel)
+	bl.enabled = true
+
+	bl.FadeNear = 1e9
+	bl.FadeFar = 1e13
+	bl.MinAlpha = 0.25
+	bl.Offset = 8
+
+	gui.Manager().SubscribeID(window.OnKeyDown, &bl, bl.onKey)
+	return
+}
+
+func (bl *BodyLabels) Dispose() {
+	gui.Manager().UnsubscribeID(window.OnKeyDown, &bl)
+}
+
+// Panel returns the gui panel which contains all labels
+func (bl *BodyLabels) Panel() gui.IPanel {
+	return bl.root
+}
+
+func (bl *BodyLabels) Enabled() bool {
+	return bl.enabled
+}
+
+func (bl *BodyLabels) SetEnabled(enabled bool) {
+	bl.enabled = enabled
+	bl.root.SetVisible(enabled)
+}
+
+func (bl *BodyLabels) alpha(dist float64) float32 {
+	if dist <= bl.FadeNear {
+		return 1
+	}
+	if dist >= bl.FadeFar {
+		return bl.MinAlpha
+	}
+	t := math.Log(dist/bl.FadeNear) / math.Log(bl.FadeFar/bl.FadeNear)
+	return 1 - (float32)(t)*(1-bl.MinAlpha)
+}
+
+// occluded reports whether the target is hidden behind another body seen from the origin
+func (bl *BodyLabels) occluded(origin mol.Vec3, target *Body) bool {
+	toTarget := bl.r.frame.AbsPos(target.Object).Subbed(origin)
+	dist := toTarget.Len()
+	if dist == 0 {
+		return false
+	}
+	dir := vecScaled(toTarget, 1/dist)
+	for _, b := range bl.r.bodies.List() {
+		if b == target || b.Radius <= 0 {
+			continue
+		}
+		toCenter := bl.r.frame.AbsPos(b.Object).Subbed(origin)
+		t := vecDot(toCenter, dir)
+		if t <= 0 || t-b.Radius > dist-target.Radius {
+			continue
+		}
+		if vecDot(toCenter, toCenter)-t*t < b.Radius*b.Radius {
+			return true
+		}
+	}
+	return false
+}
+
+func (bl *BodyLabels) update() {
+	if !bl.enabled {
+		return
+	}
+	for _, b := range bl.r.bodies.List() {
+		if _, ok := bl.index[b.Object]; !ok {
+			comp := ObjectComponent[LabelComponent](bl.r.world, b.Object)
+			if comp == nil {
+				continue
+			}
+			lb := &bodyLabel{
+				body:  b,
+				comp:  comp,
+				label: gui.NewLabel(""),
+			}
+			lb.label.SetEnabled(false)
+			lb.label.SetBounded(false)
+			bl.root.Add(lb.label)
+			bl.labels = append(bl.labels, lb)
+			bl.index[b.Object] = lb
+		}
+	}
+
+	origin := bl.r.cameraPos()
+	visible := make([]*bodyLabel, 0, len(bl.labels))
+	for _, lb := range bl.labels {
+		lb.label.SetVisible(false)
+		pos := bl.r.frame.AbsPos(lb.body.Object)
+		x, y, ok := bl.r.projectToScreen(renderPos(pos))
+		if !ok || bl.occluded(origin, lb.body) {
+			continue
+		}
+		lb.dist = pos.Subbed(origin).Len()
+		lb.x, lb.y = x+bl.Offset, y+bl.Offset
+		visible = append(visible, lb)
+	}
+
+	// nearer bodies take precedence when the labels overlap
+	sort.Slice(visible, func(i, j int) bool { return visible[i].dist < visible[j].dist })
+	placed := make([]screenRect, 0, len(visible))
+	for _, lb := range visible {
+		color := lb.body.Color
+		name := lb.comp.Text
+		if name == "" {
+			name = bl.r.world.Name(lb.body.Object)
+		}
+		lb.label.SetText(name + "\n" + units.Distance(lb.dist))
+		lb.label.SetColor4(&math32.Color4{color.R, color.G, color.B, bl.alpha(lb.dist)})
+		w, h := lb.label.Size()
+		box := screenRect{lb.x, lb.y, lb.x + w, lb.y + h}
+		if box.overlapsAny(placed) {
+			// try once to move the label above the body
+			box.y0 -= h + bl.Offset*2
+			box.y1 -= h + bl.Offset*2
+			if box.overlapsAny(placed) {
+				continue
+			}
+		}
+		placed = append(placed, box)
+		lb.label.SetPosition(box.x0, box.y0)
+		lb.label.SetVisible(true)
+	}
+}
+
+type screenRect struct {
+	x0, y0, x1, y1 float32
+}
+
+func (r screenRect) overlaps(o screenRect) bool {
+	return r.x0 < o.x1 && o.x0 < r.x1 && r.y0 < o.y1 && o.y0 < r.y1
+}
+
+func (r screenRect) overlapsAny(rects []screenRect) bool {
+	for _, o := range rects {
+		if r.overlaps(o) {
+			return true
+		}
+	}
+	return false
+}
+
+func (bl *BodyLabels) onKey(evname string, ev any) {
+	kev := ev.(*window.KeyEvent)
+	switch kev.Key {
+	case window.KeyL:
+		bl.SetEnabled(!bl.enabled)
+	}
+}
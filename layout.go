@@ -0,0 +1,227 @@
+package main
+
+import (
+	"github.com/g3n/engine/gui"
+)
+
+type LayoutAnchor int
+
+const (
+	AnchorTopLeft LayoutAnchor = iota
+	AnchorTop
+	AnchorTopRight
+	AnchorLeft
+	AnchorCenter
+	AnchorRight
+	AnchorBottomLeft
+	AnchorBottom
+	AnchorBottomRight
+	// AnchorFill docks the panel to the whole window, the panel will not be scaled
+	AnchorFill
+)
+
+// position returns the panel's position for the anchor, the margins are measured from the anchored edges
+func (a LayoutAnchor) position(winW, winH, w, h, marginX, marginY float32) (x, y float32) {
+	switch a {
+	case AnchorTopLeft, AnchorLeft, AnchorBottomLeft:
+		x = marginX
+	case AnchorTop, AnchorCenter, AnchorBottom:
+		x = (winW-w)/2 + marginX
+	case AnchorTopRight, AnchorRight, AnchorBottomRight:
+		x = winW - w - marginX
+	}
+	switch a {
+	case AnchorTopLeft, AnchorTop, AnchorTopRight:
+		y = marginY
+	case AnchorLeft, AnchorCenter, AnchorRight:
+		y = (winH-h)/2 + marginY
+	case AnchorBottomLeft, AnchorBottom, AnchorBottomRight:
+		y = winH - h - marginY
+	}
+	return
+}
+
+// panelBase is the unscaled geometry of a panel
+type panelBase struct {
+	x, y, w, h float32
+	fontSize   float64
+}
+
+type layoutItem struct {
+	panel            gui.IPanel
+	anchor           LayoutAnchor
+	marginX, marginY float32
+	bases            map[gui.IPanel]panelBase
+}
+
+// Layout places the HUD panels relative to the window's edges,
+// and scales them with the UI scale when the window is resized or its DPI changes.
+type Layout struct {
+	items    []*layoutItem
+	scaleCbs []func(scale float32)
+
+	width, height float32
+	uiScale       float32 // user setting
+	autoScale     float32 // from the monitor's content scale
+}
+
+func NewLayout() *Layout {
+	return &Layout{
+		uiScale:   1,
+		autoScale: 1,
+	}
+}
+
+// Add registers a panel with its anchor and the unscaled margins.
+// The sizes of the panel and its current descendants are recorded as the unscaled sizes.
+func (l *Layout) Add(panel gui.IPanel, anchor LayoutAnchor, marginX, marginY float32) {
+	item := &layoutItem{
+		panel:   panel,
+		anchor:  anchor,
+		marginX: marginX,
+		marginY: marginY,
+	}
+	if anchor != AnchorFill {
+		item.bases = make(map[gui.IPanel]panelBase)
+		recordPanelBase(panel, item.bases)
+	}
+	l.items = append(l.items, item)
+	l.applyItem(item)
+}
+
+// Place registers a panel which handles the UI scale by itself, the panel is only positioned
+func (l *Layout) Place(panel gui.IPanel, anchor LayoutAnchor, marginX, marginY float32) {
+	item := &layoutItem{
+		panel:   panel,
+		anchor:  anchor,
+		marginX: marginX,
+		marginY: marginY,
+	}
+	l.items = append(l.items, item)
+	l.applyItem(item)
+}
+
+// Remove unregisters the panel, the panel is not changed
+func (l *Layout) Remove(panel gui.IPanel) {
+	for i, item := range l.items {
+		if item.panel == panel {
+			l.items = append(l.items[:i], l.items[i+1:]...)
+			return
+		}
+	}
+}
+
+// OnScale registers a callback which is called with the new scale when the scale changes,
+// it's useful for the widgets that are not plain panels
+func (l *Layout) OnScale(cb func(scale float32)) {
+	l.scaleCbs = append(l.scaleCbs, cb)
+	cb(l.Scale())
+}
+
+// Scale returns the effective UI scale
+func (l *Layout) Scale() float32 {
+	return l.uiScale * l.autoScale
+}
+
+func (l *Layout) UIScale() float32 {
+	return l.uiScale
+}
+
+// SetUIScale sets the user's UI scale, it's clamped to [0.5, 3]
+func (l *Layout) SetUIScale(scale float32) {
+	if scale < 0.5 {
+		scale = 0.5
+	} else if scale > 3 {
+		scale = 3
+	}
+	if l.uiScale == scale {
+		return
+	}
+	l.uiScale = scale
+	l.Apply()
+}
+
+// Resize updates the window size and the monitor's content scale
+func (l *Layout) Resize(width, height float32, autoScale float32) {
+	if autoScale <= 0 {
+		autoScale = 1
+	}
+	if l.width == width && l.height == height && l.autoScale == autoScale {
+		// the placed panels may have changed their sizes
+		for _, item := range l.items {
+			if item.bases == nil && item.anchor != AnchorFill {
+				l.applyItem(item)
+			}
+		}
+		return
+	}
+	l.width, l.height = width, height
+	l.autoScale = autoScale
+	l.Apply()
+}
+
+// Apply repositions and rescales all panels
+func (l *Layout) Apply() {
+	scale := l.Scale()
+	for _, cb := range l.scaleCbs {
+		cb(scale)
+	}
+	for _, item := range l.items {
+		l.applyItem(item)
+	}
+}
+
+func (l *Layout) applyItem(item *layoutItem) {
+	pan := item.panel.GetPanel()
+	if item.anchor == AnchorFill {
+		pan.SetPosition(0, 0)
+		pan.SetSize(l.width, l.height)
+		return
+	}
+	scale := l.Scale()
+	scalePanelTree(item.panel, item.bases, scale, true)
+	x, y := item.anchor.position(l.width, l.height, pan.Width(), pan.Height(), item.marginX*scale, item.marginY*scale)
+	pan.SetPosition(x, y)
+}
+
+func recordPanelBase(ipan gui.IPanel, bases map[gui.IPanel]panelBase) {
+	pan := ipan.GetPanel()
+	pos := pan.Position()
+	base := panelBase{
+		x: pos.X,
+		y: pos.Y,
+		w: pan.Width(),
+		h: pan.Height(),
+	}
+	if lb, ok := ipan.(*gui.Label); ok {
+		base.fontSize = lb.FontSize()
+	}
+	bases[ipan] = base
+	for _, child := range pan.Children() {
+		if cp, ok := child.(gui.IPanel); ok {
+			recordPanelBase(cp, bases)
+		}
+	}
+}
+
+func scalePanelTree(ipan gui.IPanel, bases map[gui.IPanel]panelBase, scale float32, root bool) {
+	base, ok := bases[ipan]
+	if !ok {
+		return
+	}
+	pan := ipan.GetPanel()
+	if !root {
+		pan.SetPosition(base.x*scale, base.y*scale)
+	}
+	if lb, ok := ipan.(*gui.Label); ok {
+		// labels resize themselves to fit the text
+		lb.SetFontSize(base.fontSize * (float64)(scale))
+	} else {
+		pan.SetSize(base.w*scale, base.h*scale)
+	}
+	for _, child := range pan.Children() {
+		if cp, ok := child.(gui.IPanel); ok {
+			scalePanelTree(cp, bases, scale, false)
+		}
+	}
+}
@@ -0,0 +1,229 @@
+package main
+
+import (
+	"runtime"
+
+	"github.com/g3n/engine/geometry"
+	"github.com/g3n/engine/gls"
+)
+
+const (
+	minLODSegments = 16
+	maxLODSegments = 512
+)
+
+type geometryResult struct {
+	segments int
+	geo      *geometry.Geometry
+}
+
+type geometryEntry struct {
+	geo  *geometry.Geometry
+	refs int
+}
+
+// GeometryCache shares the unit sphere geometries of the planets by their segments.
+// The geometries are generated by a bounded worker pool, and handed back to the render thread by update.
+// Except the workers, everything runs on the render thread.
+type GeometryCache struct {
+	entries  map[int]*geometryEntry
+	segments map[*geometry.Geometry]int
+	waiting  map[int][]func(*geometry.Geometry)
+	queue    []int // segments which are not sent to the workers yet
+	idle     []int // segments of the unused geometries from the oldest
+	jobs     chan int
+	results  chan geometryResult
+	done     chan struct{}
+
+	// configs
+	MaxIdle int // unused geometries which are kept for reuse
+}
+
+func NewGeometryCache(workers int) (c *GeometryCache) {
+	if workers <= 0 {
+		workers = min(max(runtime.NumCPU()/2, 1), 4)
+	}
+	c = new(GeometryCache)
+	c.entries = make(map[int]*geometryEntry)
+	c.segments = make(map[*geometry.Geometry]int)
+	c.waiting = make(map[int][]func(*geometry.Geometry))
+	c.jobs = make(chan int, workers)
+	c.results = make(chan geometryResult, workers)
+	c.done = make(chan struct{})
+	c.MaxIdle = 8
+	for i := 0; i < workers; i++ {
+		go c.worker()
+	}
+	return
+}
+
+func newSphereGeometry(segments int) *geometry.Geometry {
+	lodRebuilds.Add(1)
+	return geometry.NewSphere(1, segments, segments)
+}
+
+func (c *GeometryCache) worker() {
+	for {
+		select {
+		case n := <-c.jobs:
+			res := geometryResult{segments: n, geo: newSphereGeometry(n)}
+			select {
+			case c.results <- res:
+			case <-c.done:
+				return
+			}
+		case <-c.done:
+			return
+		}
+	}
+}
+
+// Dispose stops the workers and releases every cached geometry
+func (c *GeometryCache) Dispose() {
+	close(c.done)
+	for _, e := range c.entries {
+		e.geo.Dispose()
+	}
+	clear(c.entries)
+	clear(c.segments)
+	clear(c.waiting)
+	c.queue, c.idle = nil, nil
+}
+
+func (c *GeometryCache) add(segments int, geo *geometry.Geometry) *geometryEntry {
+	e := &geometryEntry{geo: geo}
+	c.entries[segments] = e
+	c.segments[geo] = segments
+	return e
+}
+
+func (c *GeometryCache) ref(segments int, e *geometryEntry) *geometry.Geometry {
+	if e.refs == 0 {
+		for i, n := range c.idle {
+			if n == segments {
+				c.idle = append(c.idle[:i], c.idle[i+1:]...)
+				break
+			}
+		}
+	}
+	e.refs++
+	return e.geo
+}
+
+// Get returns the geometry of the segments, it's generated on the calling thread if it's not cached
+func (c *GeometryCache) Get(segments int) *geometry.Geometry {
+	e, ok := c.entries[segments]
+	if !ok {
+		e = c.add(segments, newSphereGeometry(segments))
+	}
+	return c.ref(segments, e)
+}
+
+// Acquire calls fn with the geometry of the segments once it's ready.
+// fn is called immediately if the geometry is cached, and the geometry must be released after use.
+func (c *GeometryCache) Acquire(segments int, fn func(*geometry.Geometry)) {
+	if e, ok := c.entries[segments]; ok {
+		fn(c.ref(segments, e))
+		return
+	}
+	waiting, ok := c.waiting[segments]
+	if !ok {
+		c.queue = append(c.queue, segments)
+	}
+	c.waiting[segments] = append(waiting, fn)
+}
+
+// Release releases the geometry which is returned by Get or Acquire
+func (c *GeometryCache) Release(geo *geometry.Geometry) {
+	segments, ok := c.segments[geo]
+	if !ok {
+		return
+	}
+	e := c.entries[segments]
+	if e.refs--; e.refs > 0 {
+		return
+	}
+	c.idle = append(c.idle, segments)
+	for len(c.idle) > c.MaxIdle {
+		n := c.idle[0]
+		c.idle = c.idle[1:]
+		old := c.entries[n]
+		delete(c.entries, n)
+		delete(c.segments, old.geo)
+		old.geo.Dispose()
+	}
+}
+
+// update sends the queued jobs to the workers and delivers the finished geometries,
+// it never blocks the render thread
+func (c *GeometryCache) update() {
+	for len(c.queue) > 0 {
+		select {
+		case c.jobs <- c.queue[0]:
+			c.queue = c.queue[1:]
+			continue
+		default:
+		}
+		break
+	}
+	for {
+		select {
+		case res := <-c.results:
+			c.deliver(res)
+		default:
+			return
+		}
+	}
+}
+
+func (c *GeometryCache) deliver(res geometryResult) {
+	e, ok := c.entries[res.segments]
+	if !ok {
+		e = c.add(res.segments, res.geo)
+		c.idle = append(c.idle, res.segments)
+	}
+	waiting := c.waiting[res.segments]
+	delete(c.waiting, res.segments)
+	for _, fn := range waiting {
+		fn(c.ref(res.segments, e))
+	}
+}
+
+// lodGeometry forwards to the current geometry of a planet,
+// so the mesh keeps its materials when the LOD changes
+type lodGeometry struct {
+	cache    *GeometryCache
+	geo      *geometry.Geometry
+	disposed bool
+}
+
+var _ geometry.IGeometry = (*lodGeometry)(nil)
+
+func (g *lodGeometry) GetGeometry() *geometry.Geometry {
+	return g.geo
+}
+
+func (g *lodGeometry) RenderSetup(gs *gls.GLS) {
+	g.geo.RenderSetup(gs)
+}
+
+func (g *lodGeometry) Dispose() {
+	g.set(nil)
+	g.disposed = true
+}
+
+// set replaces the geometry and releases the old one,
+// the geometry is released at once if it's generated after the disposal
+func (g *lodGeometry) set(geo *geometry.Geometry) {
+	if g.disposed {
+		if geo != nil {
+			g.cache.Release(geo)
+		}
+		return
+	}
+	old := g.geo
+	g.geo = geo
+	if old != nil {
+		g.cache.Release(old)
+	}
+}
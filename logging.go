@@ -0,0 +1,337 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// LogLine is a formatted log record kept for the in-game log view
+type LogLine struct {
+	Time      time.Time
+	Level     slog.Level
+	Subsystem string
+	Message   string
+}
+
+func (l LogLine) String() string {
+	return fmt.Sprintf("%s %-5s [%s] %s", l.Time.Format("15:04:05"), l.Level, l.Subsystem, l.Message)
+}
+
+// LogBuffer is a ring buffer of the recent log lines
+type LogBuffer struct {
+	mux   sync.Mutex
+	lines []LogLine
+	next  int
+	full  bool
+}
+
+func NewLogBuffer(size int) *LogBuffer {
+	return &LogBuffer{
+		lines: make([]LogLine, size),
+	}
+}
+
+func (b *LogBuffer) Add(line LogLine) {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+	b.lines[b.next] = line
+	if b.next++; b.next == len(b.lines) {
+		b.next = 0
+		b.full = true
+	}
+}
+
+// Recent returns at most n lines which are at least the level, from the oldest to the newest
+func (b *LogBuffer) Recent(n int, level slog.Level) (lines []LogLine) {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+	count := b.next
+	if b.full {
+		count = len(b.lines)
+	}
+	for i := 1; i <= count && len(lines) < n; i++ {
+		line := b.lines[(b.next-i+len(b.lines))%len(b.lines)]
+		if line.Level >= level {
+			lines = append(lines, line)
+		}
+	}
+	for i, j := 0, len(lines)-1; i < j; i, j = i+1, j-1 {
+		lines[i], lines[j] = lines[j], lines[i]
+	}
+	return
+}
+
+// switchWriter is a writer whose destination can be changed after the handlers are created
+type switchWriter struct {
+	mux sync.Mutex
+	w   io.Writer
+}
+
+func (w *switchWriter) Write(p []byte) (int, error) {
+	w.mux.Lock()
+	defer w.mux.Unlock()
+	return w.w.Write(p)
+}
+
+func (w *switchWriter) set(dst io.Writer) {
+	w.mux.Lock()
+	defer w.mux.Unlock()
+	w.w = dst
+}
+
+// Logging owns the subsystem loggers, their levels, the output and the recent lines
+type Logging struct {
+	mux    sync.Mutex
+	levels map[string]*slog.LevelVar
+	level  slog.LevelVar // level of the subsystems which are not set
+
+	out    *switchWriter
+	file   *os.File
+	text   slog.Handler
+	Buffer *LogBuffer
+}
+
+func NewLogging(w io.Writer) (l *Logging) {
+	l = new(Logging)
+	l.levels = make(map[string]*slog.LevelVar)
+	l.out = &switchWriter{w: w}
+	l.text = slog.NewTextHandler(l.out, &slog.HandlerOptions{
+		// the levels are filtered by the subsystem handlers
+		Level: slog.Level(-1 << 10),
+	})
+	l.Buffer = NewLogBuffer(1000)
+	return
+}
+
+// Logger returns the logger of the subsystem
+func (l *Logging) Logger(subsystem string) *slog.Logger {
+	return slog.New(&logHandler{
+		l:         l,
+		subsystem: subsystem,
+		text:      l.text.WithAttrs([]slog.Attr{slog.String("sys", subsystem)}),
+	})
+}
+
+func (l *Logging) levelVar(subsystem string) *slog.LevelVar {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+	return l.levels[subsystem]
+}
+
+// Level returns the minimum level of the subsystem
+func (l *Logging) Level(subsystem string) slog.Level {
+	if v := l.levelVar(subsystem); v != nil {
+		return v.Level()
+	}
+	return l.level.Level()
+}
+
+// SetLevel sets the minimum level of the subsystem, the empty subsystem sets the default level
+func (l *Logging) SetLevel(subsystem string, level slog.Level) {
+	if subsystem == "" {
+		l.level.Set(level)
+		return
+	}
+	l.mux.Lock()
+	defer l.mux.Unlock()
+	v, ok := l.levels[subsystem]
+	if !ok {
+		v = new(slog.LevelVar)
+		l.levels[subsystem] = v
+	}
+	v.Set(level)
+}
+
+// ResetLevel makes the subsystem use the default level
+func (l *Logging) ResetLevel(subsystem string) {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+	delete(l.levels, subsystem)
+}
+
+// Subsystems returns the subsystems which have their own levels
+func (l *Logging) Subsystems() (names []string) {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+	for name := range l.levels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return
+}
+
+// OpenFile writes the logs to the file as well, the previous file is closed
+func (l *Logging) OpenFile(path string) (err error) {
+	fd, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return
+	}
+	l.mux.Lock()
+	old := l.file
+	l.file = fd
+	l.mux.Unlock()
+	l.out.set(io.MultiWriter(os.Stderr, fd))
+	if old != nil {
+		old.Close()
+	}
+	return
+}
+
+// Close closes the log file
+func (l *Logging) Close() error {
+	l.mux.Lock()
+	fd := l.file
+	l.file = nil
+	l.mux.Unlock()
+	l.out.set(os.Stderr)
+	if fd != nil {
+		return fd.Close()
+	}
+	return nil
+}
+
+type logHandler struct {
+	l         *Logging
+	subsystem string
+	text      slog.Handler
+	attrs     string // preformatted attributes for the log buffer
+	group     string
+}
+
+var _ slog.Handler = (*logHandler)(nil)
+
+func (h *logHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return level >= h.l.Level(h.subsystem)
+}
+
+func (h *logHandler) Handle(ctx context.Context, r slog.Record) error {
+	var msg strings.Builder
+	msg.WriteString(r.Message)
+	msg.WriteString(h.attrs)
+	r.Attrs(func(a slog.Attr) bool {
+		writeLogAttr(&msg, h.group, a)
+		return true
+	})
+	h.l.Buffer.Add(LogLine{
+		Time:      r.Time,
+		Level:     r.Level,
+		Subsystem: h.subsystem,
+		Message:   msg.String(),
+	})
+	return h.text.Handle(ctx, r)
+}
+
+func (h *logHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	var sb strings.Builder
+	sb.WriteString(h.attrs)
+	for _, a := range attrs {
+		writeLogAttr(&sb, h.group, a)
+	}
+	h2 := *h
+	h2.text = h.text.WithAttrs(attrs)
+	h2.attrs = sb.String()
+	return &h2
+}
+
+func (h *logHandler) WithGroup(name string) slog.Handler {
+	h2 := *h
+	h2.text = h.text.WithGroup(name)
+	h2.group = h.group + name + "."
+	return &h2
+}
+
+func writeLogAttr(sb *strings.Builder, group string, a slog.Attr) {
+	if a.Equal(slog.Attr{}) {
+		return
+	}
+	if a.Value.Kind() == slog.KindGroup {
+		for _, ga := range a.Value.Group() {
+			writeLogAttr(sb, group+a.Key+".", ga)
+		}
+		return
+	}
+	sb.WriteByte(' ')
+	sb.WriteString(group)
+	sb.WriteString(a.Key)
+	sb.WriteByte('=')
+	sb.WriteString(a.Value.Resolve().String())
+}
+
+var logging = NewLogging(os.Stderr)
+
+// the subsystem loggers
+var (
+	coreLog    = logging.Logger("core")
+	worldLog   = logging.Logger("world")
+	physicsLog = logging.Logger("physics")
+	renderLog  = logging.Logger("render")
+	uiLog      = logging.Logger("ui")
+	consoleLog = logging.Logger("console")
+)
+
+// logSubsystems lists the names of the subsystem loggers
+var logSubsystems = []string{"core", "world", "physics", "render", "ui", "console"}
+
+var logLevelNames = []string{"debug", "info", "warn", "error"}
+
+// logSubsystemName maps "default" to the empty subsystem which means the default level
+func logSubsystemName(name string) string {
+	if name == "default" {
+		return ""
+	}
+	return name
+}
+
+func init() {
+	// route the standard log package and the third party logs to the core logger
+	slog.SetDefault(coreLog)
+}
+
+// LogSettings configures the logging
+type LogSettings struct {
+	// File is the log file, the relative path is resolved from the settings file's directory
+	File string `json:"file,omitempty"`
+	// Level is the default level, e.g. "info" or "debug"
+	Level string `json:"level,omitempty"`
+	// Levels are the levels of the subsystems
+	Levels map[string]string `json:"levels,omitempty"`
+}
+
+// apply configures the logging with the settings, the invalid levels are reported and ignored
+func (s *LogSettings) apply(l *Logging, dir string) {
+	if s.Level != "" {
+		var level slog.Level
+		if err := level.UnmarshalText([]byte(s.Level)); err != nil {
+			coreLog.Warn("invalid log level", "level", s.Level, "err", err)
+		} else {
+			l.SetLevel("", level)
+		}
+	}
+	for sys, name := range s.Levels {
+		var level slog.Level
+		if err := level.UnmarshalText([]byte(name)); err != nil {
+			coreLog.Warn("invalid log level", "subsystem", sys, "level", name, "err", err)
+			continue
+		}
+		l.SetLevel(sys, level)
+	}
+	if s.File != "" {
+		path := s.File
+		if !filepath.IsAbs(path) && dir != "" {
+			path = filepath.Join(dir, path)
+		}
+		if err := l.OpenFile(path); err != nil {
+			coreLog.Error("cannot open log file", "path", path, "err", err)
+		} else {
+			coreLog.Info("logging to file", "path", path)
+		}
+	}
+}
@@ -1,8 +1,198 @@
-package main_test
+package main
 
 import (
+	"math"
+	"path/filepath"
 	"testing"
+
+	"github.com/LiterMC/curve/units"
+	mol "github.com/LiterMC/molecular"
 )
 
 func TestBuildOk(t *testing.T) {
 }
+
+func TestSetTimeWarp(t *testing.T) {
+	r := new(Runner)
+	for _, tc := range []struct {
+		warp, want float64
+	}{
+		{2, 2},
+		{math.NaN(), 2},
+		{-1, 0},
+		{1e300, maxTimeWarp},
+		{math.Inf(1), maxTimeWarp},
+	} {
+		r.SetTimeWarp(tc.warp)
+		if got := r.TimeWarp(); got != tc.want {
+			t.Errorf("SetTimeWarp(%g) set x%g, expected x%g", tc.warp, got, tc.want)
+		}
+	}
+}
+
+func TestSetUnitSystem(t *testing.T) {
+	defer func(system *units.System) { units.Default.System = system }(units.Default.System)
+	r := new(Runner)
+	r.settings = defaultSettings()
+	r.settingsPath = filepath.Join(t.TempDir(), "settings.json")
+	r.setUnitSystem(units.Imperial)
+	if units.Default.System != units.Imperial {
+		t.Errorf("the default unit system is %s, expected %s", units.Default.System.Name, units.Imperial.Name)
+	}
+	s, err := LoadSettings(r.settingsPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Units != units.Imperial.Name {
+		t.Errorf("the saved unit system is %q, expected %q", s.Units, units.Imperial.Name)
+	}
+}
+
+// relState returns the state of o relative to the anchor, which does not have to be o's anchor
+func relState(o, anchor PhysicsObject) (pos, vel mol.Vec3) {
+	absVel := func(o PhysicsObject) (vel mol.Vec3) {
+		for ; o != nil; o = o.AnchorLocked() {
+			vel.Add(o.VelocityLocked())
+		}
+		return
+	}
+	pos = o.AbsPosLocked().Subbed(anchor.AbsPosLocked())
+	vel = absVel(o).Subbed(absVel(anchor))
+	return
+}
+
+func findBody(t *testing.T, r *Runner, name string) PhysicsObject {
+	t.Helper()
+	o := r.world.Find(name)
+	if o == nil {
+		t.Fatalf("%s not found", name)
+	}
+	return o
+}
+
+// scenarioCase simulates the default scenario day by day
+type scenarioCase struct {
+	name string
+	days int
+	// step is called after every simulated day, it returns false to stop the simulation
+	step func(t *testing.T, r *Runner, day int) bool
+	// done is called after the simulation
+	done func(t *testing.T, r *Runner)
+}
+
+// earthPeriodCase accumulates Earth's angle around the Sun every day, and interpolates the time of the full turn
+func earthPeriodCase() (tc scenarioCase) {
+	const want = 365.25
+	var last, total, period float64
+	angle := func(t *testing.T, r *Runner) float64 {
+		pos, _ := relState(findBody(t, r, "Earth"), findBody(t, r, "Sun"))
+		return math.Atan2(pos.Z, pos.X)
+	}
+	tc.name = "EarthOrbitalPeriod"
+	tc.days = 400
+	tc.step = func(t *testing.T, r *Runner, day int) bool {
+		if day == 1 {
+			// Earth starts at -X
+			last = math.Pi
+		}
+		a := angle(t, r)
+		da := math.Remainder(a-last, 2*math.Pi)
+		last = a
+		if math.Abs(total+da) >= 2*math.Pi {
+			period = (float64)(day-1) + (2*math.Pi-math.Abs(total))/math.Abs(da)
+			return false
+		}
+		total += da
+		return true
+	}
+	tc.done = func(t *testing.T, r *Runner) {
+		if period == 0 {
+			t.Fatalf("Earth did not finish an orbit in %d days", tc.days)
+		}
+		if math.Abs(period-want)/want > 0.001 {
+			t.Errorf("Earth's orbital period is %.2f days, expected %.2f", period, want)
+		}
+	}
+	return
+}
+
+func TestDefaultScenario(t *testing.T) {
+	for _, backend := range []struct {
+		name    string
+		physics func() PhysicsBackend
+		skip    string
+	}{
+		{
+			name:    "molecular",
+			physics: func() PhysicsBackend { return NewMolBackend(mol.Config{}) },
+			skip:    "the scenario is not run against the molecular engine yet",
+		},
+		{
+			name:    "nbody",
+			physics: func() PhysicsBackend { return newTestNBody(IntegratorLeapfrog) },
+		},
+	} {
+		t.Run(backend.name, func(t *testing.T) {
+			if backend.skip != "" {
+				t.Skip(backend.skip)
+			}
+			runScenarioCases(t, backend.physics)
+		})
+	}
+}
+
+func runScenarioCases(t *testing.T, physics func() PhysicsBackend) {
+	for _, tc := range []scenarioCase{
+		{
+			name: "PlayerPlacement",
+			done: func(t *testing.T, r *Runner) {
+				earth := findBody(t, r, "Earth")
+				if anchor := r.playerObj.AnchorLocked(); anchor != earth {
+					t.Errorf("player is attached to %s, expected Earth", r.world.Name(anchor))
+				}
+				want := math.Hypot(earthRad, earthRad+1e7) - earthRad
+				pos, vel := relState(r.playerObj, earth)
+				if alt := pos.Len() - earthRad; math.Abs(alt-want) > 1 {
+					t.Errorf("player's altitude is %g m, expected %g m", alt, want)
+				}
+				if speed := vel.Len(); speed > 1e-6 {
+					t.Errorf("player moves at %g m/s relative to Earth, expected at rest", speed)
+				}
+			},
+		},
+		earthPeriodCase(),
+		{
+			name: "MoonStaysBound",
+			days: 365,
+			step: func(t *testing.T, r *Runner, day int) bool {
+				moon, earth := findBody(t, r, "Moon"), findBody(t, r, "Earth")
+				pos, vel := relState(moon, earth)
+				dist := pos.Len()
+				mu := gravConst * (earthMass + moonMass)
+				if energy := vecDot(vel, vel)/2 - mu/dist; energy >= 0 {
+					t.Fatalf("Moon escaped from Earth on day %d, orbital energy is %g J/kg", day, energy)
+				}
+				// the moon must stay inside earth's hill sphere
+				earthPos, _ := relState(earth, findBody(t, r, "Sun"))
+				hill := earthPos.Len() * math.Cbrt(earthMass/(3*sunMass))
+				if dist > hill {
+					t.Fatalf("Moon is %g m away from Earth on day %d, outside the hill sphere %g m", dist, day, hill)
+				}
+				return true
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r := newHeadlessRunner(t, physics())
+			for d := 1; d <= tc.days; d++ {
+				tickDay(r.physics)
+				if tc.step != nil && !tc.step(t, r, d) {
+					break
+				}
+			}
+			if tc.done != nil {
+				tc.done(t, r)
+			}
+		})
+	}
+}
@@ -0,0 +1,451 @@
+package main
+
+import (
+	"math"
+	"time"
+
+	"github.com/LiterMC/curve/units"
+	"github.com/g3n/engine/core"
+	"github.com/g3n/engine/geometry"
+	"github.com/g3n/engine/gls"
+	"github.com/g3n/engine/graphic"
+	"github.com/g3n/engine/gui"
+	"github.com/g3n/engine/material"
+	"github.com/g3n/engine/math32"
+	"github.com/g3n/engine/window"
+)
+
+const (
+	mapIconSize     = 8
+	mapOrbitSamples = 256
+)
+
+type mapBody struct {
+	*Body
+
+	icon     *gui.Panel
+	orbit    *graphic.LineStrip
+	orbitPos *gls.VBO
+	orbitCol *gls.VBO
+	soi      *graphic.Mesh
+}
+
+// MapView is the zoomed out orbital overview.
+// It orbits the camera around the selected body, draws every body as an icon,
+// draws the orbit of every object relative to its anchor and the spheres of influence,
+// and marks the player's next SOI transition.
+type MapView struct {
+	r *Runner
+
+	root   *core.Node
+	icons  *gui.Panel
+	bodies []*mapBody
+	index  map[PhysicsObject]*mapBody
+
+	transIcon  *gui.Panel
+	transLabel *gui.Label
+
+	// status
+	active       bool
+	blend        float32 // 0 is flight view, 1 is map view
+	target       PhysicsObject
+	yaw, pitch   float32
+	distance     float32
+	dragging     bool
+	lastX, lastY float32
+	flightQuat   math32.Quaternion
+
+	// configs
+	TransitionTime time.Duration
+	MinDistance    float32
+	MaxDistance    float32
+	RotSpeed       float32
+	ZoomSpeed      float32
+	SOIOpacity     float32
+}
+
+func NewMapView(r *Runner) (mv *MapView) {
+	mv = new(MapView)
+	mv.r = r
+	mv.root = core.NewNode()
+	mv.root.SetVisible(false)
+	mv.icons = gui.NewPanel(0, 0)
+	mv.icons.SetEnabled(false)
+	mv.icons.SetVisible(false)
+	mv.index = make(map[PhysicsObject]*mapBody)
+	mv.pitch = 30 * math32.Pi / 180
+
+	mv.transIcon = gui.NewPanel(mapIconSize, mapIconSize)
+	mv.transIcon.SetBorders(2, 2, 2, 2)
+	mv.transIcon.SetBordersColor(&math32.Color{1, 0.8, 0.2})
+	mv.transIcon.SetColor4(&math32.Color4{0, 0, 0, 0})
+	mv.transIcon.SetEnabled(false)
+	mv.transIcon.SetBounded(false)
+	mv.icons.Add(mv.transIcon)
+	mv.transLabel = gui.NewLabel("")
+	mv.transLabel.SetColor(&math32.Color{1, 0.8, 0.2})
+	mv.transLabel.SetEnabled(false)
+	mv.transLabel.SetBounded(false)
+	mv.icons.Add(mv.transLabel)
+
+	mv.TransitionTime = time.Millisecond * 600
+	mv.MinDistance = 1
+	mv.MaxDistance = 1e5
+	mv.RotSpeed = 0.3
+	mv.ZoomSpeed = 0.1
+	mv.SOIOpacity = 0.12
+
+	mnr := gui.Manager()
+	mnr.SubscribeID(window.OnKeyDown, &mv, mv.onKey)
+	mnr.SubscribeID(window.OnMouseDown, &mv, mv.onMouse)
+	mnr.SubscribeID(window.OnMouseUp, &mv, mv.onMouse)
+	mnr.SubscribeID(window.OnCursor, &mv, mv.onCursor)
+	mnr.SubscribeID(window.OnScroll, &mv, mv.onScroll)
+	return
+}
+
+func (mv *MapView) Dispose() {
+	mnr := gui.Manager()
+	mnr.UnsubscribeID(window.OnKeyDown, &mv)
+	mnr.UnsubscribeID(window.OnMouseDown, &mv)
+	mnr.UnsubscribeID(window.OnMouseUp, &mv)
+	mnr.UnsubscribeID(window.OnCursor, &mv)
+	mnr.UnsubscribeID(window.OnScroll, &mv)
+}
+
+// Node returns the scene node which contains the orbit lines
+func (mv *MapView) Node() core.INode {
+	return mv.root
+}
+
+// Icons returns the gui panel which contains the body icons
+func (mv *MapView) Icons() gui.IPanel {
+	return mv.icons
+}
+
+func (mv *MapView) Active() bool {
+	return mv.active
+}
+
+func (mv *MapView) Target() PhysicsObject {
+	return mv.target
+}
+
+func (mv *MapView) SetTarget(o PhysicsObject) {
+	mv.target = o
+}
+
+// Toggle switches between the map view and the flight view
+func (mv *MapView) Toggle() {
+	if mv.active {
+		mv.Close()
+	} else {
+		mv.Open()
+	}
+}
+
+// Open enters the map view, the flight controls will be disabled until Close is called
+func (mv *MapView) Open() {
+	if mv.active {
+		return
+	}
+	mv.active = true
+	if mv.blend == 0 {
+		mv.flightQuat = mv.r.cam.Quaternion()
+	}
+	mv.r.player.SetInputEnabled(false)
+	if mv.target == nil {
+		mv.target = mv.r.frame.Anchor(mv.r.playerObj)
+	}
+	if mv.target != nil && mv.blend == 0 {
+		diff := mv.r.frame.AbsPos(mv.r.playerObj).Subbed(mv.r.frame.AbsPos(mv.target))
+		mv.distance = mv.clampDistance((float32)(diff.Len() / posScale * 3))
+	}
+	mv.root.SetVisible(true)
+	mv.icons.SetVisible(true)
+}
+
+// Close starts the transition back to the flight view
+func (mv *MapView) Close() {
+	if !mv.active {
+		return
+	}
+	mv.active = false
+	mv.dragging = false
+}
+
+// CycleTarget selects the next (or previous if step is negative) body as the orbit target
+func (mv *MapView) CycleTarget(step int) {
+	if o := mv.r.bodies.Next(mv.target, step, nil); o != nil {
+		mv.target = o
+	}
+}
+
+func (mv *MapView) clampDistance(d float32) float32 {
+	if d < mv.MinDistance {
+		return mv.MinDistance
+	}
+	if d > mv.MaxDistance {
+		return mv.MaxDistance
+	}
+	return d
+}
+
+func (mv *MapView) Tick(dt time.Duration) {
+	if mv.active {
+		if mv.TransitionTime <= 0 {
+			mv.blend = 1
+		} else if mv.blend += (float32)(dt) / (float32)(mv.TransitionTime); mv.blend > 1 {
+			mv.blend = 1
+		}
+	} else if mv.blend > 0 {
+		if mv.TransitionTime <= 0 {
+			mv.blend = 0
+		} else if mv.blend -= (float32)(dt) / (float32)(mv.TransitionTime); mv.blend < 0 {
+			mv.blend = 0
+		}
+		if mv.blend == 0 {
+			mv.leave()
+			return
+		}
+	}
+	if mv.blend == 0 {
+		return
+	}
+	mv.updateBodies()
+	mv.updateCamera()
+	mv.updateOrbits()
+	mv.updateSOIs()
+	mv.updateIcons()
+	mv.updateTransition()
+}
+
+// leave is called when the transition back to the flight view is finished
+func (mv *MapView) leave() {
+	mv.root.SetVisible(false)
+	mv.icons.SetVisible(false)
+	mv.r.cam.SetQuaternionQuat(&mv.flightQuat)
+	mv.r.player.SetInputEnabled(true)
+}
+
+func (mv *MapView) updateBodies() {
+	for _, b := range mv.r.bodies.List() {
+		if _, ok := mv.index[b.Object]; !ok {
+			mv.newBody(b)
+		}
+	}
+}
+
+func (mv *MapView) newBody(b *Body) (body *mapBody) {
+	body = &mapBody{
+		Body: b,
+	}
+	body.icon = gui.NewPanel(mapIconSize, mapIconSize)
+	body.icon.SetColor(&b.Color)
+	body.icon.SetBorders(1, 1, 1, 1)
+	body.icon.SetBordersColor(&math32.Color{1, 1, 1})
+	body.icon.SetEnabled(false)
+	body.icon.SetBounded(false)
+	mv.icons.Add(body.icon)
+
+	geom := geometry.NewGeometry()
+	body.orbitPos = gls.NewVBO(math32.NewArrayF32(0, mapOrbitSamples*3)).AddAttrib(gls.VertexPosition)
+	body.orbitCol = gls.NewVBO(math32.NewArrayF32(0, mapOrbitSamples*3)).AddAttrib(gls.VertexColor)
+	geom.AddVBO(body.orbitPos)
+	geom.AddVBO(body.orbitCol)
+	mat := material.NewBasic()
+	mat.SetDepthTest(false)
+	body.orbit = graphic.NewLineStrip(geom, mat)
+	body.orbit.SetCullable(false)
+	mv.root.Add(body.orbit)
+
+	soiGeo := &lodGeometry{cache: mv.r.geometries}
+	soiGeo.set(mv.r.geometries.Get(minLODSegments * 2))
+	soiMat := material.NewStandard(&b.Color)
+	soiMat.SetOpacity(mv.SOIOpacity)
+	soiMat.SetTransparent(true)
+	soiMat.SetDepthMask(false)
+	soiMat.SetSide(material.SideDouble)
+	body.soi = graphic.NewMesh(soiGeo, soiMat)
+	body.soi.SetVisible(false)
+	mv.root.Add(body.soi)
+
+	mv.bodies = append(mv.bodies, body)
+	mv.index[b.Object] = body
+	return
+}
+
+func (mv *MapView) updateCamera() {
+	cam := mv.r.cam
+	var target math32.Vector3
+	if mv.target != nil {
+		target = renderPos(mv.r.frame.AbsPos(mv.target))
+	}
+	cosPitch := math32.Cos(mv.pitch)
+	offset := math32.Vector3{
+		X: cosPitch * math32.Sin(mv.yaw),
+		Y: math32.Sin(mv.pitch),
+		Z: cosPitch * math32.Cos(mv.yaw),
+	}
+	offset.MultiplyScalar(mv.distance)
+	mapPos := target
+	mapPos.Add(&offset)
+
+	cam.SetPositionVec(&mapPos)
+	cam.LookAt(&target, unitY)
+	if mv.blend >= 1 {
+		return
+	}
+	mapQuat := cam.Quaternion()
+
+	// smoothstep the transition
+	t := mv.blend * mv.blend * (3 - 2*mv.blend)
+	flightPos := renderPos(mv.r.player.ViewPos())
+	pos := flightPos
+	pos.Lerp(&mapPos, t)
+	quat := mv.flightQuat
+	quat.Slerp(&mapQuat, t)
+	cam.SetPositionVec(&pos)
+	cam.SetQuaternionQuat(&quat)
+}
+
+func (mv *MapView) updateOrbits() {
+	for _, body := range mv.bodies {
+		state := mv.r.frame.State(body.Object)
+		anchor := state.Anchor
+		var orbit Orbit
+		ok := false
+		if anchor != nil {
+			if ab, exists := mv.index[anchor]; exists {
+				orbit, ok = NewOrbit(state.Pos, state.Vel, gravConst*ab.Mass)
+			}
+		}
+		body.orbit.SetVisible(ok)
+		if !ok {
+			continue
+		}
+		origin := renderPos(mv.r.frame.AbsPos(anchor))
+		body.orbit.SetPositionVec(&origin)
+
+		points := orbit.Points(mapOrbitSamples)
+		positions := math32.NewArrayF32(0, len(points)*3)
+		colors := math32.NewArrayF32(0, len(points)*3)
+		for _, p := range points {
+			p.ScaleN(1. / posScale)
+			positions.Append((float32)(p.X), (float32)(p.Y), (float32)(p.Z))
+			colors.AppendColor(&body.Color)
+		}
+		body.orbitPos.SetBuffer(positions)
+		body.orbitCol.SetBuffer(colors)
+	}
+}
+
+// updateSOIs draws the sphere of influence of every body which has an anchor
+func (mv *MapView) updateSOIs() {
+	for _, body := range mv.bodies {
+		radius := mv.r.soiRadius(body.Object)
+		// the root body has an infinite sphere, and the sphere inside the surface is not drawn
+		if math.IsInf(radius, 0) || body.Radius <= 0 || radius <= body.Radius {
+			body.soi.SetVisible(false)
+			continue
+		}
+		pos := renderPos(mv.r.frame.AbsPos(body.Object))
+		scale := (float32)(radius / posScale)
+		body.soi.SetPositionVec(&pos)
+		body.soi.SetScale(scale, scale, scale)
+		body.soi.SetVisible(true)
+	}
+}
+
+// updateTransition marks where the player's trajectory leaves or enters a sphere of influence
+func (mv *MapView) updateTransition() {
+	player := mv.r.playerObj
+	tr, ok := mv.r.predictSOITransition(player)
+	if ok {
+		anchor := mv.r.frame.Anchor(player)
+		var x, y float32
+		x, y, ok = mv.r.projectToScreen(renderPos(vecAdded(mv.r.frame.AbsPos(anchor), tr.Pos)))
+		if ok {
+			var text string
+			if tr.Exit {
+				text = "Leave " + mv.r.world.Name(anchor)
+			} else {
+				text = "Enter " + mv.r.world.Name(tr.Child)
+			}
+			mv.transLabel.SetText(text + " in " + units.Duration(tr.Time))
+			mv.transIcon.SetPosition(x-mapIconSize/2, y-mapIconSize/2)
+			mv.transLabel.SetPosition(x+mapIconSize, y-mv.transLabel.Height()/2)
+		}
+	}
+	mv.transIcon.SetVisible(ok)
+	mv.transLabel.SetVisible(ok)
+}
+
+func (mv *MapView) updateIcons() {
+	for _, body := range mv.bodies {
+		x, y, ok := mv.r.projectToScreen(renderPos(mv.r.frame.AbsPos(body.Object)))
+		body.icon.SetVisible(ok)
+		if ok {
+			body.icon.SetPosition(x-mapIconSize/2, y-mapIconSize/2)
+		}
+	}
+}
+
+func (mv *MapView) onKey(evname string, ev any) {
+	kev := ev.(*window.KeyEvent)
+	switch kev.Key {
+	case window.KeyM:
+		mv.Toggle()
+	case window.KeyTab:
+		if !mv.active {
+			return
+		}
+		if kev.Mods&window.ModShift != 0 {
+			mv.CycleTarget(-1)
+		} else {
+			mv.CycleTarget(1)
+		}
+	}
+}
+
+func (mv *MapView) onMouse(evname string, ev any) {
+	if !mv.active {
+		return
+	}
+	mev := ev.(*window.MouseEvent)
+	if mev.Button != window.MouseButtonLeft {
+		return
+	}
+	switch evname {
+	case window.OnMouseDown:
+		mv.dragging = true
+		mv.lastX, mv.lastY = mev.Xpos, mev.Ypos
+	case window.OnMouseUp:
+		mv.dragging = false
+	}
+}
+
+func (mv *MapView) onCursor(evname string, ev any) {
+	if !mv.active || !mv.dragging {
+		return
+	}
+	cev := ev.(*window.CursorEvent)
+	dx, dy := cev.Xpos-mv.lastX, cev.Ypos-mv.lastY
+	mv.lastX, mv.lastY = cev.Xpos, cev.Ypos
+	mv.yaw -= dx * math.Pi / 180 * mv.RotSpeed
+	mv.pitch += dy * math.Pi / 180 * mv.RotSpeed
+	const maxPitch = math32.Pi/2 - 0.01
+	if mv.pitch > maxPitch {
+		mv.pitch = maxPitch
+	} else if mv.pitch < -maxPitch {
+		mv.pitch = -maxPitch
+	}
+}
+
+func (mv *MapView) onScroll(evname string, ev any) {
+	if !mv.active {
+		return
+	}
+	sev := ev.(*window.ScrollEvent)
+	mv.distance = mv.clampDistance(mv.distance * math32.Pow(1-mv.ZoomSpeed, sev.Yoffset))
+}
@@ -0,0 +1,148 @@
+package main
+
+import (
+	mol "github.com/LiterMC/molecular"
+	"github.com/g3n/engine/gui"
+	"github.com/g3n/engine/math32"
+	"github.com/g3n/engine/window"
+)
+
+// orbitalDirections returns the unit vectors of the orbital frame
+// from the position and velocity relative to the anchor
+func orbitalDirections(pos, vel mol.Vec3) (prograde, radialOut, normal mol.Vec3) {
+	prograde = vecNormalized(vel)
+	radialOut = vecNormalized(pos)
+	normal = vecNormalized(vecCross(pos, vel))
+	return
+}
+
+type markerKind int
+
+const (
+	markerPrograde markerKind = iota
+	markerRetrograde
+	markerRadialOut
+	markerRadialIn
+	markerNormal
+	markerAntiNormal
+	markerTarget
+	markerAntiTarget
+
+	markerCount
+)
+
+var markerStyles = [markerCount]struct {
+	text  string
+	color math32.Color
+}{
+	markerPrograde:   {"PRO", math32.Color{1.0, 0.9, 0.2}},
+	markerRetrograde: {"RET", math32.Color{1.0, 0.9, 0.2}},
+	markerRadialOut:  {"RAD+", math32.Color{0.3, 0.8, 1.0}},
+	markerRadialIn:   {"RAD-", math32.Color{0.3, 0.8, 1.0}},
+	markerNormal:     {"NRM+", math32.Color{0.9, 0.3, 1.0}},
+	markerAntiNormal: {"NRM-", math32.Color{0.9, 0.3, 1.0}},
+	markerTarget:     {"TGT", math32.Color{1.0, 0.5, 0.0}},
+	markerAntiTarget: {"TGT-", math32.Color{1.0, 0.5, 0.0}},
+}
+
+// OrbitalMarkers draws the direction markers of the player's orbit around the reticle
+type OrbitalMarkers struct {
+	r       *Runner
+	root    *gui.Panel
+	markers [markerCount]*gui.Label
+	enabled bool
+
+	// configs
+	EdgeMargin float32 // pixels between the clamped markers and the window edge
+}
+
+func NewOrbitalMarkers(r *Runner) (om *OrbitalMarkers) {
+	om = new(OrbitalMarkers)
+	om.r = r
+	om.root = gui.NewPanel(0, 0)
+	om.root.SetEnabled(false)
+	om.enabled = true
+	om.EdgeMargin = 24
+	for i := range om.markers {
+		style := &markerStyles[i]
+		lb := gui.NewLabel(style.text)
+		lb.SetColor(&style.color)
+		lb.SetEnabled(false)
+		lb.SetBounded(false)
+		om.root.Add(lb)
+		om.markers[i] = lb
+	}
+	gui.Manager().SubscribeID(window.OnKeyDown, &om, om.onKey)
+	return
+}
+
+func (om *OrbitalMarkers) Dispose() {
+	gui.Manager().UnsubscribeID(window.OnKeyDown, &om)
+}
+
+// Panel returns the gui panel which contains all markers
+func (om *OrbitalMarkers) Panel() gui.IPanel {
+	return om.root
+}
+
+func (om *OrbitalMarkers) Enabled() bool {
+	return om.enabled
+}
+
+func (om *OrbitalMarkers) SetEnabled(enabled bool) {
+	om.enabled = enabled
+	om.root.SetVisible(enabled)
+}
+
+func (om *OrbitalMarkers) setMarker(kind markerKind, dir mol.Vec3) {
+	lb := om.markers[kind]
+	if dir.Len() == 0 {
+		lb.SetVisible(false)
+		return
+	}
+	x, y, onScreen := om.r.projectDirection(dir, om.EdgeMargin)
+	w, h := lb.Size()
+	lb.SetPosition(x-w/2, y-h/2)
+	color := markerStyles[kind].color
+	alpha := float32(1)
+	if !onScreen {
+		alpha = 0.5
+	}
+	lb.SetColor4(&math32.Color4{color.R, color.G, color.B, alpha})
+	lb.SetVisible(true)
+}
+
+func (om *OrbitalMarkers) update() {
+	if !om.enabled {
+		return
+	}
+	if om.r.mapView.Active() {
+		om.root.SetVisible(false)
+		return
+	}
+	om.root.SetVisible(true)
+
+	player, f := om.r.playerObj, &om.r.frame
+	prograde, radialOut, normal := orbitalDirections(f.Pos(player), f.Velocity(player))
+	om.setMarker(markerPrograde, prograde)
+	om.setMarker(markerRetrograde, vecScaled(prograde, -1))
+	om.setMarker(markerRadialOut, radialOut)
+	om.setMarker(markerRadialIn, vecScaled(radialOut, -1))
+	om.setMarker(markerNormal, normal)
+	om.setMarker(markerAntiNormal, vecScaled(normal, -1))
+
+	var toTarget mol.Vec3
+	if target := om.r.targeting.Target(); target != nil {
+		toTarget = vecNormalized(f.AbsPos(target).Subbed(f.AbsPos(player)))
+	}
+	om.setMarker(markerTarget, toTarget)
+	om.setMarker(markerAntiTarget, vecScaled(toTarget, -1))
+}
+
+func (om *OrbitalMarkers) onKey(evname string, ev any) {
+	kev := ev.(*window.KeyEvent)
+	switch kev.Key {
+	case window.KeyK:
+		om.SetEnabled(!om.enabled)
+	}
+}
@@ -0,0 +1,299 @@
+package main
+
+import (
+	"fmt"
+	"math"
+
+	mol "github.com/LiterMC/molecular"
+	"github.com/g3n/engine/camera"
+	"github.com/g3n/engine/core"
+	"github.com/g3n/engine/geometry"
+	"github.com/g3n/engine/gls"
+	"github.com/g3n/engine/graphic"
+	"github.com/g3n/engine/gui"
+	"github.com/g3n/engine/material"
+	"github.com/g3n/engine/math32"
+	"github.com/g3n/engine/renderer"
+)
+
+var (
+	navballSkyColor    = math32.Color{0.25, 0.55, 0.9}
+	navballGroundColor = math32.Color{0.55, 0.35, 0.15}
+	navballLineColor   = math32.Color{0.9, 0.9, 0.9}
+)
+
+// horizonBasis returns the local horizon frame at the position relative to the anchor.
+// North points to the anchor's +Y axis, or +Z if the position is above a pole.
+func horizonBasis(pos mol.Vec3) (east, up, north mol.Vec3) {
+	up = vecNormalized(pos)
+	if up.Len() == 0 {
+		up = mol.Vec3{Y: 1}
+	}
+	ref := mol.Vec3{Y: 1}
+	if math.Abs(vecDot(ref, up)) > 0.999 {
+		ref = mol.Vec3{Z: 1}
+	}
+	north = vecNormalized(vecAdded(ref, vecScaled(up, -vecDot(ref, up))))
+	east = vecCross(north, up)
+	return
+}
+
+// attitude returns the heading, pitch and roll in degrees of the forward, up and right vectors
+// in the horizon frame
+func attitude(forward, up, right, hEast, hUp, hNorth mol.Vec3) (heading, pitch, roll float64) {
+	heading = math.Atan2(vecDot(forward, hEast), vecDot(forward, hNorth)) * 180 / math.Pi
+	if heading < 0 {
+		heading += 360
+	}
+	pitch = math.Asin(math.Max(-1, math.Min(1, vecDot(forward, hUp)))) * 180 / math.Pi
+	roll = math.Atan2(-vecDot(right, hUp), vecDot(up, hUp)) * 180 / math.Pi
+	return
+}
+
+// Navball is the attitude instrument.
+// The ball is drawn in its own scene and camera at the bottom of the window,
+// it shows the camera's orientation relative to the local horizon of the player's anchor.
+type Navball struct {
+	r *Runner
+
+	scene   *core.Node
+	mirror  *core.Node // flips Z, so the ball is seen from the inside like a real navball
+	ball    *core.Node // the ball's local frame is X east, Y up, Z south
+	cam     *camera.Camera
+	markers [markerCount]*graphic.Points
+
+	panel      *gui.Panel
+	guiHeading *gui.Label
+	guiPitch   *gui.Label
+	guiRoll    *gui.Label
+
+	enabled bool
+	scale   float32
+
+	// configs
+	Size         float32 // unscaled size of the ball in pixels
+	BottomMargin float32
+}
+
+func NewNavball(r *Runner) (nb *Navball) {
+	nb = new(Navball)
+	nb.r = r
+	nb.enabled = true
+	nb.scale = 1
+	nb.Size = 160
+	nb.BottomMargin = 10
+
+	nb.scene = core.NewNode()
+	nb.mirror = core.NewNode()
+	nb.mirror.SetScale(1, 1, -1)
+	nb.scene.Add(nb.mirror)
+	nb.ball = core.NewNode()
+	nb.mirror.Add(nb.ball)
+
+	sphere := geometry.NewSphere(1, 48, 24)
+	colors := math32.NewArrayF32(0, sphere.Items()*3)
+	sphere.ReadVertices(func(v math32.Vector3) bool {
+		if v.Y >= 0 {
+			colors.AppendColor(&navballSkyColor)
+		} else {
+			colors.AppendColor(&navballGroundColor)
+		}
+		return false
+	})
+	sphere.AddVBO(gls.NewVBO(colors).AddAttrib(gls.VertexColor))
+	sphereMat := material.NewBasic()
+	sphereMat.SetSide(material.SideDouble)
+	nb.ball.Add(graphic.NewMesh(sphere, sphereMat))
+	nb.ball.Add(newNavballLines())
+
+	for i := range nb.markers {
+		color := markerStyles[i].color
+		mat := material.NewPoint(&color)
+		mat.SetSize(40)
+		mat.SetDepthTest(false)
+		geom := geometry.NewGeometry()
+		positions := math32.NewArrayF32(0, 3)
+		positions.Append(0, 0, 0)
+		geom.AddVBO(gls.NewVBO(positions).AddAttrib(gls.VertexPosition))
+		p := graphic.NewPoints(geom, mat)
+		p.SetVisible(false)
+		nb.ball.Add(p)
+		nb.markers[i] = p
+	}
+
+	nb.cam = camera.NewPerspective(1, 0.1, 10, 35, camera.Vertical)
+	nb.cam.SetPosition(0, 0, 3.5)
+	nb.scene.Add(nb.cam)
+
+	nb.panel = gui.NewPanel(90, 22*3)
+	nb.panel.SetColor4(&math32.Color4{0.7, 0.7, 0.7, 0.5})
+	nb.panel.SetPaddings(2, 4, 2, 4)
+	nb.guiHeading = gui.NewLabel("")
+	nb.panel.Add(nb.guiHeading)
+	nb.guiPitch = gui.NewLabel("")
+	nb.guiPitch.SetPositionY(22)
+	nb.panel.Add(nb.guiPitch)
+	nb.guiRoll = gui.NewLabel("")
+	nb.guiRoll.SetPositionY(44)
+	nb.panel.Add(nb.guiRoll)
+	return
+}
+
+// newNavballLines creates the horizon, pitch rings and heading meridians of the ball
+func newNavballLines() *graphic.Lines {
+	const (
+		radius   = 1.002
+		segments = 72
+	)
+	positions := math32.NewArrayF32(0, 0)
+	addLine := func(a, b math32.Vector3) {
+		positions.Append(a.X, a.Y, a.Z, b.X, b.Y, b.Z)
+	}
+	point := func(heading, pitch float64) math32.Vector3 {
+		cp := math.Cos(pitch) * radius
+		// X east, Y up, Z south
+		return math32.Vector3{
+			X: (float32)(cp * math.Sin(heading)),
+			Y: (float32)(math.Sin(pitch) * radius),
+			Z: (float32)(-cp * math.Cos(heading)),
+		}
+	}
+	for _, deg := range []float64{-60, -30, 0, 30, 60} {
+		pitch := deg * math.Pi / 180
+		for i := 0; i < segments; i++ {
+			h0 := (float64)(i) / segments * 2 * math.Pi
+			h1 := (float64)(i+1) / segments * 2 * math.Pi
+			addLine(point(h0, pitch), point(h1, pitch))
+		}
+	}
+	for deg := 0.0; deg < 360; deg += 45 {
+		heading := deg * math.Pi / 180
+		for i := 0; i < segments/2; i++ {
+			p0 := ((float64)(i)/(segments/2) - 0.5) * math.Pi
+			p1 := ((float64)(i+1)/(segments/2) - 0.5) * math.Pi
+			addLine(point(heading, p0), point(heading, p1))
+		}
+	}
+	colors := math32.NewArrayF32(0, positions.Len())
+	for i := 0; i < positions.Len(); i += 3 {
+		colors.AppendColor(&navballLineColor)
+	}
+	geom := geometry.NewGeometry()
+	geom.AddVBO(gls.NewVBO(positions).AddAttrib(gls.VertexPosition))
+	geom.AddVBO(gls.NewVBO(colors).AddAttrib(gls.VertexColor))
+	return graphic.NewLines(geom, material.NewBasic())
+}
+
+// Panel returns the gui panel of the heading, pitch and roll readouts
+func (nb *Navball) Panel() gui.IPanel {
+	return nb.panel
+}
+
+// SetScale sets the UI scale of the ball
+func (nb *Navball) SetScale(scale float32) {
+	nb.scale = scale
+}
+
+func (nb *Navball) Enabled() bool {
+	return nb.enabled
+}
+
+func (nb *Navball) SetEnabled(enabled bool) {
+	nb.enabled = enabled
+	nb.panel.SetVisible(enabled)
+}
+
+// viewport returns the navball's viewport in window coordinates
+func (nb *Navball) viewport() (x, y, size float32) {
+	w, h := nb.r.GetSize()
+	size = nb.Size * nb.scale
+	return ((float32)(w) - size) / 2, (float32)(h) - size - nb.BottomMargin*nb.scale, size
+}
+
+func (nb *Navball) update() {
+	visible := nb.enabled && !nb.r.mapView.Active()
+	nb.panel.SetVisible(visible)
+	if !visible {
+		return
+	}
+	player, f := nb.r.playerObj, &nb.r.frame
+	pos, vel := f.Pos(player), f.Velocity(player)
+	hEast, hUp, hNorth := horizonBasis(pos)
+
+	var quat math32.Quaternion
+	nb.r.cam.WorldQuaternion(&quat)
+	axis := func(v math32.Vector3) mol.Vec3 {
+		v.ApplyQuaternion(&quat)
+		return ToMolVec3(&v)
+	}
+	forward, up, right := axis(math32.Vector3{0, 0, -1}), axis(math32.Vector3{0, 1, 0}), axis(math32.Vector3{1, 0, 0})
+	heading, pitch, roll := attitude(forward, up, right, hEast, hUp, hNorth)
+	nb.guiHeading.SetText(fmt.Sprintf("HDG %5.1f", heading))
+	nb.guiPitch.SetText(fmt.Sprintf("PIT %+5.1f", pitch))
+	nb.guiRoll.SetText(fmt.Sprintf("ROL %+5.1f", roll))
+
+	// the ball's rotation is inverse(camera) * horizon
+	south := vecScaled(hNorth, -1)
+	var basis math32.Matrix4
+	basis.MakeBasis(ToG3NVec3(&hEast), ToG3NVec3(&hUp), ToG3NVec3(&south))
+	var horizon, ballQuat math32.Quaternion
+	horizon.SetFromRotationMatrix(&basis)
+	inv := quat
+	inv.Inverse()
+	ballQuat.MultiplyQuaternions(&inv, &horizon)
+	nb.ball.SetQuaternionQuat(&ballQuat)
+
+	// markers in the ball's local frame
+	toLocal := func(dir mol.Vec3) math32.Vector3 {
+		return math32.Vector3{
+			X: (float32)(vecDot(dir, hEast)),
+			Y: (float32)(vecDot(dir, hUp)),
+			Z: (float32)(vecDot(dir, south)),
+		}
+	}
+	prograde, radialOut, normal := orbitalDirections(pos, vel)
+	var toTarget mol.Vec3
+	if target := nb.r.targeting.Target(); target != nil {
+		toTarget = vecNormalized(f.AbsPos(target).Subbed(f.AbsPos(player)))
+	}
+	dirs := [markerCount]mol.Vec3{
+		markerPrograde:   prograde,
+		markerRetrograde: vecScaled(prograde, -1),
+		markerRadialOut:  radialOut,
+		markerRadialIn:   vecScaled(radialOut, -1),
+		markerNormal:     normal,
+		markerAntiNormal: vecScaled(normal, -1),
+		markerTarget:     toTarget,
+		markerAntiTarget: vecScaled(toTarget, -1),
+	}
+	for i, dir := range dirs {
+		m := nb.markers[i]
+		if dir.Len() == 0 {
+			m.SetVisible(false)
+			continue
+		}
+		local := toLocal(dir)
+		local.MultiplyScalar(1.01)
+		m.SetPositionVec(&local)
+		m.SetVisible(true)
+	}
+}
+
+// render draws the ball over the rendered main scene
+func (nb *Navball) render(rend *renderer.Renderer) {
+	if !nb.enabled || nb.r.mapView.Active() {
+		return
+	}
+	x, y, size := nb.viewport()
+	_, h := nb.r.GetSize()
+	scaleX, scaleY := nb.r.GetScale()
+	gs := nb.r.Gls()
+	gs.Viewport(
+		(int32)((float64)(x)*scaleX), (int32)((float64)((float32)(h)-y-size)*scaleY),
+		(int32)((float64)(size)*scaleX), (int32)((float64)(size)*scaleY))
+	gs.Clear(gls.DEPTH_BUFFER_BIT)
+	rend.Render(nb.scene, nb.cam)
+
+	w, _ := nb.r.GetSize()
+	gs.Viewport(0, 0, (int32)((float64)(w)*scaleX), (int32)((float64)(h)*scaleY))
+}
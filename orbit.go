@@ -0,0 +1,182 @@
+package main
+
+import (
+	"math"
+
+	mol "github.com/LiterMC/molecular"
+)
+
+const gravConst = 6.6743e-11
+
+// Orbit is a keplerian two body orbit relative to the anchor
+type Orbit struct {
+	Mu           float64 // standard gravitational parameter of the anchor
+	SemiLatus    float64
+	Eccentricity float64
+	Periapsis    mol.Vec3 // unit vector points to the periapsis
+	Normal       mol.Vec3 // unit vector of the angular momentum
+	TrueAnomaly  float64
+}
+
+// NewOrbit calculates the orbit from the relative position and velocity to the anchor.
+// ok will be false if the orbit is degenerated, e.g. a radial trajectory or a massless anchor.
+func NewOrbit(pos, vel mol.Vec3, mu float64) (o Orbit, ok bool) {
+	o.Mu = mu
+	h := vecCross(pos, vel)
+	hl := h.Len()
+	r := pos.Len()
+	if mu <= 0 || hl == 0 || r == 0 {
+		return
+	}
+	o.Normal = vecScaled(h, 1/hl)
+	o.SemiLatus = hl * hl / mu
+	// e = ((v^2 - mu/r) * r - (r . v) * v) / mu
+	ev := vecAdded(
+		vecScaled(pos, (vecDot(vel, vel)-mu/r)/mu),
+		vecScaled(vel, -vecDot(pos, vel)/mu))
+	o.Eccentricity = ev.Len()
+	if o.Eccentricity < 1e-9 {
+		o.Eccentricity = 0
+		o.Periapsis = vecScaled(pos, 1/r)
+	} else {
+		o.Periapsis = vecScaled(ev, 1/o.Eccentricity)
+	}
+	q := vecCross(o.Normal, o.Periapsis)
+	o.TrueAnomaly = math.Atan2(vecDot(pos, q), vecDot(pos, o.Periapsis))
+	return o, true
+}
+
+// Bound reports whether the orbit is closed
+func (o *Orbit) Bound() bool {
+	return o.Eccentricity < 1
+}
+
+// SemiMajor returns the semi-major axis, it's negative for hyperbolic orbits
+func (o *Orbit) SemiMajor() float64 {
+	return o.SemiLatus / (1 - o.Eccentricity*o.Eccentricity)
+}
+
+// Period returns the orbital period in seconds, or +Inf if the orbit is not bound
+func (o *Orbit) Period() float64 {
+	if !o.Bound() {
+		return math.Inf(1)
+	}
+	a := o.SemiMajor()
+	return 2 * math.Pi * math.Sqrt(a*a*a/o.Mu)
+}
+
+func (o *Orbit) PeriapsisDist() float64 {
+	return o.SemiLatus / (1 + o.Eccentricity)
+}
+
+// ApoapsisDist returns the apoapsis distance, or +Inf if the orbit is not bound
+func (o *Orbit) ApoapsisDist() float64 {
+	if !o.Bound() {
+		return math.Inf(1)
+	}
+	return o.SemiLatus / (1 - o.Eccentricity)
+}
+
+// PosAt returns the position relative to the anchor at the true anomaly nu
+func (o *Orbit) PosAt(nu float64) mol.Vec3 {
+	r := o.SemiLatus / (1 + o.Eccentricity*math.Cos(nu))
+	q := vecCross(o.Normal, o.Periapsis)
+	return vecAdded(vecScaled(o.Periapsis, r*math.Cos(nu)), vecScaled(q, r*math.Sin(nu)))
+}
+
+// maxHyperbolicDist limits how far the open orbits are sampled, in multiple of the periapsis distance
+const maxHyperbolicDist = 50
+
+// Points samples n points along the orbit.
+// Closed orbits are sampled for a full revolution,
+// and open orbits are sampled until they are too far from the anchor.
+func (o *Orbit) Points(n int) []mol.Vec3 {
+	if n < 2 {
+		return nil
+	}
+	from, to := -math.Pi, math.Pi
+	if !o.Bound() {
+		// r = p / (1 + e cos nu) <= maxHyperbolicDist * rp
+		lim := math.Acos((o.SemiLatus/(maxHyperbolicDist*o.PeriapsisDist()) - 1) / o.Eccentricity)
+		from, to = -lim, lim
+	}
+	points := make([]mol.Vec3, n)
+	step := (to - from) / (float64)(n-1)
+	for i := range points {
+		points[i] = o.PosAt(from + step*(float64)(i))
+	}
+	return points
+}
+
+// meanMotion returns the mean motion in rad/s, the orbit must not be parabolic
+func (o *Orbit) meanMotion() float64 {
+	a := math.Abs(o.SemiMajor())
+	return math.Sqrt(o.Mu / (a * a * a))
+}
+
+// meanAnomaly returns the mean anomaly at the true anomaly nu
+func (o *Orbit) meanAnomaly(nu float64) float64 {
+	e := o.Eccentricity
+	if o.Bound() {
+		ea := 2 * math.Atan(math.Sqrt((1-e)/(1+e))*math.Tan(nu/2))
+		return ea - e*math.Sin(ea)
+	}
+	h := 2 * math.Atanh(math.Sqrt((e-1)/(e+1))*math.Tan(nu/2))
+	return e*math.Sinh(h) - h
+}
+
+// trueAnomaly solves Kepler's equation for the true anomaly at the mean anomaly m
+func (o *Orbit) trueAnomaly(m float64) float64 {
+	e := o.Eccentricity
+	if o.Bound() {
+		m = math.Remainder(m, 2*math.Pi)
+		ea := m
+		if e > 0.8 {
+			ea = math.Copysign(math.Pi, m)
+		}
+		for i := 0; i < 50; i++ {
+			d := (ea - e*math.Sin(ea) - m) / (1 - e*math.Cos(ea))
+			if ea -= d; math.Abs(d) < 1e-12 {
+				break
+			}
+		}
+		return 2 * math.Atan2(math.Sqrt(1+e)*math.Sin(ea/2), math.Sqrt(1-e)*math.Cos(ea/2))
+	}
+	h := math.Asinh(m / e)
+	for i := 0; i < 50; i++ {
+		d := (e*math.Sinh(h) - h - m) / (e*math.Cosh(h) - 1)
+		if h -= d; math.Abs(d) < 1e-12 {
+			break
+		}
+	}
+	return 2 * math.Atan(math.Sqrt((e+1)/(e-1))*math.Tanh(h/2))
+}
+
+// TrueAnomalyAfter returns the true anomaly t seconds after the current position.
+// The parabolic orbits are treated as slightly hyperbolic.
+func (o *Orbit) TrueAnomalyAfter(t float64) float64 {
+	orb := *o
+	if math.Abs(orb.Eccentricity-1) < 1e-9 {
+		orb.Eccentricity = 1 + 1e-9
+	}
+	return orb.trueAnomaly(orb.meanAnomaly(orb.TrueAnomaly) + orb.meanMotion()*t)
+}
+
+// PosAfter returns the position relative to the anchor t seconds after the current position
+func (o *Orbit) PosAfter(t float64) mol.Vec3 {
+	return o.PosAt(o.TrueAnomalyAfter(t))
+}
+
+// TimeTo returns the seconds from the current position to the true anomaly nu,
+// it's in the next revolution for the closed orbits, and negative if the open orbit has passed nu
+func (o *Orbit) TimeTo(nu float64) float64 {
+	orb := *o
+	if math.Abs(orb.Eccentricity-1) < 1e-9 {
+		orb.Eccentricity = 1 + 1e-9
+	}
+	dm := orb.meanAnomaly(nu) - orb.meanAnomaly(orb.TrueAnomaly)
+	if orb.Bound() && dm < 0 {
+		dm += 2 * math.Pi
+	}
+	return dm / orb.meanMotion()
+}
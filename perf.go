@@ -0,0 +1,353 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"runtime/debug"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/g3n/engine/gui"
+	"github.com/g3n/engine/math32"
+	"github.com/g3n/engine/window"
+)
+
+type perfSample struct {
+	t time.Time
+	v float64
+}
+
+// PerfSeries is a ring buffer of timed samples, it's safe for concurrent use
+type PerfSeries struct {
+	mux     sync.Mutex
+	samples []perfSample
+	next    int
+	full    bool
+	count   int64   // samples ever added
+	total   float64 // sum of the samples ever added
+}
+
+func NewPerfSeries(size int) *PerfSeries {
+	return &PerfSeries{
+		samples: make([]perfSample, size),
+	}
+}
+
+func (s *PerfSeries) Add(t time.Time, v float64) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.samples[s.next] = perfSample{t, v}
+	s.count++
+	s.total += v
+	if s.next++; s.next == len(s.samples) {
+		s.next = 0
+		s.full = true
+	}
+}
+
+// Totals returns the count and the sum of all samples ever added
+func (s *PerfSeries) Totals() (count int64, total float64) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	return s.count, s.total
+}
+
+// Last returns the newest sample, ok is false if there is no sample
+func (s *PerfSeries) Last() (v float64, ok bool) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if s.count == 0 {
+		return 0, false
+	}
+	return s.samples[(s.next-1+len(s.samples))%len(s.samples)].v, true
+}
+
+// each calls fn with the samples after the time from the oldest to the newest
+func (s *PerfSeries) each(since time.Time, fn func(perfSample)) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	count, start := s.next, 0
+	if s.full {
+		count, start = len(s.samples), s.next
+	}
+	for i := 0; i < count; i++ {
+		sample := s.samples[(start+i)%len(s.samples)]
+		if sample.t.After(since) {
+			fn(sample)
+		}
+	}
+}
+
+// Values returns the values after the time from the oldest to the newest
+func (s *PerfSeries) Values(since time.Time) (values []float64) {
+	s.each(since, func(sample perfSample) {
+		values = append(values, sample.v)
+	})
+	return
+}
+
+// Buckets splits the window which ends at now into len(out) buckets,
+// and stores the largest value of each bucket, so the short spikes are still visible.
+func (s *PerfSeries) Buckets(now time.Time, window time.Duration, out []float32) {
+	for i := range out {
+		out[i] = 0
+	}
+	since := now.Add(-window)
+	s.each(since, func(sample perfSample) {
+		i := (int)((float64)(sample.t.Sub(since)) / (float64)(window) * (float64)(len(out)))
+		if i >= len(out) {
+			i = len(out) - 1
+		}
+		if v := (float32)(sample.v); v > out[i] {
+			out[i] = v
+		}
+	})
+}
+
+// PerfSummary is the statistics of the samples in a window
+type PerfSummary struct {
+	Count         int
+	Min, Avg, Max float64
+	P99           float64
+	Sum           float64
+}
+
+func summarize(values []float64) (s PerfSummary) {
+	s.Count = len(values)
+	if s.Count == 0 {
+		return
+	}
+	sorted := make([]float64, len(values))
+	copy(sorted, values)
+	sort.Float64s(sorted)
+	for _, v := range sorted {
+		s.Sum += v
+	}
+	s.Min, s.Max = sorted[0], sorted[len(sorted)-1]
+	s.Avg = s.Sum / (float64)(s.Count)
+	s.P99 = sorted[(int)(math.Ceil(0.99*(float64)(s.Count)))-1]
+	return
+}
+
+// Summary returns the statistics of the samples after the time
+func (s *PerfSeries) Summary(since time.Time) PerfSummary {
+	return summarize(s.Values(since))
+}
+
+// PerfStats collects the performance samples of the render and physics loops
+type PerfStats struct {
+	Frame   *PerfSeries // frame time in ms
+	Tick    *PerfSeries // physics tick duration in ms
+	Events  *PerfSeries // physics events per tick
+	Objects *PerfSeries // bodies in the scene
+	GCPause *PerfSeries // GC pause in ms
+	LOD     *PerfSeries // LOD rebuilds per frame
+
+	gcStats   debug.GCStats
+	lastNumGC int64
+	lastLOD   int64
+}
+
+func NewPerfStats() (ps *PerfStats) {
+	ps = new(PerfStats)
+	// the physics loop ticks every 10ms, and the frames are usually slower
+	ps.Frame = NewPerfSeries(2048)
+	ps.Tick = NewPerfSeries(2048)
+	ps.Events = NewPerfSeries(2048)
+	ps.Objects = NewPerfSeries(2048)
+	ps.GCPause = NewPerfSeries(256)
+	ps.LOD = NewPerfSeries(2048)
+	debug.ReadGCStats(&ps.gcStats)
+	ps.lastNumGC = ps.gcStats.NumGC
+	return
+}
+
+// collectGC records the GC pauses which happened after the last call
+func (ps *PerfStats) collectGC() {
+	debug.ReadGCStats(&ps.gcStats)
+	n := (int)(ps.gcStats.NumGC - ps.lastNumGC)
+	ps.lastNumGC = ps.gcStats.NumGC
+	if n > len(ps.gcStats.Pause) {
+		n = len(ps.gcStats.Pause)
+	}
+	// the pauses are sorted from the newest
+	for i := n - 1; i >= 0; i-- {
+		ps.GCPause.Add(ps.gcStats.PauseEnd[i], durationMs(ps.gcStats.Pause[i]))
+	}
+}
+
+// recordFrame is called by the render loop once per frame
+func (ps *PerfStats) recordFrame(now time.Time, dt time.Duration, objects int) {
+	ps.Frame.Add(now, durationMs(dt))
+	ps.Objects.Add(now, (float64)(objects))
+	lod := lodRebuilds.Load()
+	ps.LOD.Add(now, (float64)(lod-ps.lastLOD))
+	ps.lastLOD = lod
+	ps.collectGC()
+}
+
+// recordTick is called by the physics loop after every tick
+func (ps *PerfStats) recordTick(now time.Time, spt time.Duration, events int) {
+	ps.Tick.Add(now, durationMs(spt))
+	ps.Events.Add(now, (float64)(events))
+}
+
+func durationMs(d time.Duration) float64 {
+	return (float64)(d) / (float64)(time.Millisecond)
+}
+
+type perfGraph struct {
+	title  string
+	unit   string
+	series *PerfSeries
+	label  *gui.Label
+	chart  *gui.Chart
+	graph  *gui.Graph
+	data   []float32
+}
+
+// PerfOverlay plots the performance samples of the last few seconds, it's toggled with F3
+type PerfOverlay struct {
+	r          *Runner
+	panel      *gui.Panel
+	graphs     []*perfGraph
+	lodLabel   *gui.Label
+	enabled    bool
+	lastUpdate time.Time
+
+	// configs
+	Window         time.Duration // time range of the graphs
+	Buckets        int
+	UpdateInterval time.Duration
+}
+
+func NewPerfOverlay(r *Runner) (po *PerfOverlay) {
+	po = new(PerfOverlay)
+	po.r = r
+	po.Window = 5 * time.Second
+	po.Buckets = 100
+	po.UpdateInterval = 100 * time.Millisecond
+
+	const (
+		width       = 320
+		chartHeight = 56
+	)
+	po.panel = gui.NewPanel(width+10, 0)
+	po.panel.SetPaddings(5, 5, 5, 5)
+	po.panel.SetColor4(&math32.Color4{0.1, 0.1, 0.1, 0.7})
+	po.panel.SetEnabled(false)
+	po.panel.SetVisible(false)
+
+	stats := r.perf
+	var y float32
+	addGraph := func(title, unit string, series *PerfSeries, color math32.Color) {
+		g := &perfGraph{
+			title:  title,
+			unit:   unit,
+			series: series,
+			data:   make([]float32, po.Buckets),
+		}
+		g.label = gui.NewLabel(title)
+		g.label.SetColor(&math32.Color{0.9, 0.9, 0.9})
+		g.label.SetPositionY(y)
+		po.panel.Add(g.label)
+		y += g.label.Height()
+
+		g.chart = gui.NewChart(width, chartHeight)
+		g.chart.SetColor4(&math32.Color4{0, 0, 0, 0.3})
+		g.chart.SetMarginX(2)
+		g.chart.SetMarginY(36)
+		g.chart.SetFontSizeY(10)
+		g.chart.SetFormatY("%.1f")
+		g.chart.SetScaleY(2, &math32.Color{0.4, 0.4, 0.4})
+		g.chart.SetRangeX(0, 1, (float32)(po.Buckets-1))
+		g.chart.SetPositionY(y)
+		g.graph = g.chart.AddLineGraph(&color, g.data)
+		po.panel.Add(g.chart)
+		y += chartHeight + 4
+		po.graphs = append(po.graphs, g)
+	}
+	addGraph("Frame", "ms", stats.Frame, math32.Color{0.3, 1, 0.3})
+	addGraph("Tick", "ms", stats.Tick, math32.Color{1, 0.8, 0.2})
+	addGraph("Events", "", stats.Events, math32.Color{0.3, 0.8, 1})
+	addGraph("Objects", "", stats.Objects, math32.Color{0.8, 0.8, 0.8})
+	addGraph("GC pause", "ms", stats.GCPause, math32.Color{1, 0.3, 0.3})
+
+	po.lodLabel = gui.NewLabel("")
+	po.lodLabel.SetColor(&math32.Color{0.9, 0.9, 0.9})
+	po.lodLabel.SetPositionY(y)
+	po.panel.Add(po.lodLabel)
+	y += po.lodLabel.Height()
+	po.panel.SetContentHeight(y)
+
+	if err := errors.Join(
+		r.hud.Register("fps", "FPS:", func() (string, bool) {
+			return fmt.Sprint(r.fps), true
+		}),
+		r.hud.Register("ticktime", "Tick Time:", func() (string, bool) {
+			return time.Duration(r.tickTime.Load()).String(), true
+		}),
+	); err != nil {
+		uiLog.Error("cannot register the HUD widgets", "err", err)
+	}
+
+	gui.Manager().SubscribeID(window.OnKeyDown, &po, po.onKey)
+	return
+}
+
+func (po *PerfOverlay) Dispose() {
+	gui.Manager().UnsubscribeID(window.OnKeyDown, &po)
+}
+
+// Panel returns the gui panel of the graphs
+func (po *PerfOverlay) Panel() gui.IPanel {
+	return po.panel
+}
+
+func (po *PerfOverlay) Enabled() bool {
+	return po.enabled
+}
+
+func (po *PerfOverlay) SetEnabled(enabled bool) {
+	po.enabled = enabled
+	po.panel.SetVisible(enabled)
+}
+
+func formatPerfValue(v float64, unit string) string {
+	if unit == "" {
+		return fmt.Sprintf("%.0f", v)
+	}
+	return fmt.Sprintf("%.2f%s", v, unit)
+}
+
+func (po *PerfOverlay) update(now time.Time) {
+	if !po.enabled || now.Sub(po.lastUpdate) < po.UpdateInterval {
+		return
+	}
+	po.lastUpdate = now
+	since := now.Add(-po.Window)
+	for _, g := range po.graphs {
+		s := g.series.Summary(since)
+		g.label.SetText(fmt.Sprintf("%s  min %s  avg %s  p99 %s", g.title,
+			formatPerfValue(s.Min, g.unit), formatPerfValue(s.Avg, g.unit), formatPerfValue(s.P99, g.unit)))
+		g.series.Buckets(now, po.Window, g.data)
+		maxY := (float32)(s.Max) * 1.1
+		if maxY <= 0 {
+			maxY = 1
+		}
+		g.chart.SetRangeY(0, maxY)
+		g.graph.SetData(g.data)
+	}
+	lod := po.r.perf.LOD.Summary(since)
+	po.lodLabel.SetText(fmt.Sprintf("LOD rebuilds in %v: %.0f", po.Window, lod.Sum))
+}
+
+func (po *PerfOverlay) onKey(evname string, ev any) {
+	kev := ev.(*window.KeyEvent)
+	switch kev.Key {
+	case window.KeyF3:
+		po.SetEnabled(!po.enabled)
+	}
+}
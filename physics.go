@@ -0,0 +1,122 @@
+package main
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	mol "github.com/LiterMC/molecular"
+)
+
+// ObjectType is the kind of a physics object
+type ObjectType int
+
+const (
+	NaturalObject ObjectType = iota
+	LivingObject
+)
+
+// PhysicsObject is an object simulated by a PhysicsBackend.
+// The positions and velocities are relative to the anchor unless they are absolute.
+// The Locked methods lock the backend by themselves and can be called from any goroutine.
+// The other methods don't lock, they are only valid while the backend is locked,
+// which is inside the NewObject callbacks and Block.Tick. The other goroutines queue their
+// changes in a ChangeQueue, which is applied by the Tick of the object's block.
+type PhysicsObject interface {
+	fmt.Stringer
+	Id() string
+
+	Anchor() PhysicsObject
+	AnchorLocked() PhysicsObject
+	Pos() mol.Vec3
+	PosLocked() mol.Vec3
+	AbsPos() mol.Vec3
+	AbsPosLocked() mol.Vec3
+	Velocity() mol.Vec3
+	VelocityLocked() mol.Vec3
+
+	SetPos(pos mol.Vec3)
+	SetVelocity(vel mol.Vec3)
+	AttachTo(anchor PhysicsObject)
+	SetRadius(radius float64)
+	// FillGfields makes the object attract the others with its mass
+	FillGfields()
+	AddBlock(b Block)
+}
+
+// Block is a part of a physics object, the object's mass is the sum of its blocks.
+// Tick is called by the physics goroutine while the backend is locked.
+type Block interface {
+	SetObject(o PhysicsObject)
+	Mass() float64
+	Tick(dt float64)
+}
+
+// PhysicsBackend creates, steps and iterates the physics objects.
+// Tick is called by the physics goroutine, and the other methods are safe to call concurrently.
+type PhysicsBackend interface {
+	// NewObject creates an object attached to the anchor, cb is called with the backend locked
+	// before the object is simulated
+	NewObject(typ ObjectType, anchor PhysicsObject, pos mol.Vec3, cb func(PhysicsObject)) PhysicsObject
+	Tick(dt time.Duration)
+	// Events returns how many events are processed by the last tick
+	Events() int
+	ForeachObject(cb func(PhysicsObject))
+	ForeachBlock(cb func(Block))
+}
+
+// ChangeQueue holds the changes of an object which are made by the other goroutines,
+// until the object's block applies them in its Tick
+type ChangeQueue struct {
+	mux     sync.Mutex
+	changes []func(o PhysicsObject)
+}
+
+// Do queues the change, it's applied in the next physics tick
+func (q *ChangeQueue) Do(change func(o PhysicsObject)) {
+	q.mux.Lock()
+	defer q.mux.Unlock()
+	q.changes = append(q.changes, change)
+}
+
+// apply runs the queued changes in order, it must be called inside Block.Tick
+func (q *ChangeQueue) apply(o PhysicsObject) {
+	q.mux.Lock()
+	changes := q.changes
+	q.changes = nil
+	q.mux.Unlock()
+	if o == nil {
+		return
+	}
+	for _, change := range changes {
+		change(o)
+	}
+}
+
+// PhysicsSettings selects the physics backend
+type PhysicsSettings struct {
+	// Backend is "molecular" or "nbody"
+	Backend string `json:"backend,omitempty"`
+	// Integrator is the integrator of the nbody backend, see ParseIntegrator
+	Integrator string `json:"integrator,omitempty"`
+}
+
+// NewPhysicsBackend creates the backend which is selected by the settings
+func NewPhysicsBackend(s PhysicsSettings) (PhysicsBackend, error) {
+	switch s.Backend {
+	case "", "molecular":
+		return NewMolBackend(mol.Config{}), nil
+	case "nbody":
+		integrator := IntegratorLeapfrog
+		if s.Integrator != "" {
+			var err error
+			if integrator, err = ParseIntegrator(s.Integrator); err != nil {
+				return nil, err
+			}
+		}
+		nb := NewNBodyBackend()
+		nb.Integrator = integrator
+		return nb, nil
+	}
+	return nil, fmt.Errorf("unknown physics backend %q", s.Backend)
+}
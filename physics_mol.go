@@ -0,0 +1,202 @@
+package main
+
+import (
+	"sync/atomic"
+	"time"
+
+	mol "github.com/LiterMC/molecular"
+)
+
+// MolBackend is the PhysicsBackend of the molecular engine
+type MolBackend struct {
+	eng *mol.Engine
+}
+
+var _ PhysicsBackend = (*MolBackend)(nil)
+
+func NewMolBackend(cfg mol.Config) *MolBackend {
+	return &MolBackend{
+		eng: mol.NewEngine(cfg),
+	}
+}
+
+// Engine returns the underlying molecular engine
+func (b *MolBackend) Engine() *mol.Engine {
+	return b.eng
+}
+
+func (b *MolBackend) NewObject(typ ObjectType, anchor PhysicsObject, pos mol.Vec3, cb func(PhysicsObject)) PhysicsObject {
+	t := mol.NaturalObj
+	if typ == LivingObject {
+		t = mol.LivingObj
+	}
+	o := b.eng.NewObject(t, unwrapMolObject(anchor), pos, func(o *mol.Object) {
+		if cb != nil {
+			cb(wrapMolObject(o))
+		}
+	})
+	return wrapMolObject(o)
+}
+
+func (b *MolBackend) Tick(dt time.Duration) {
+	b.eng.Tick(dt)
+}
+
+func (b *MolBackend) Events() int {
+	return (int)(b.eng.Events())
+}
+
+func (b *MolBackend) ForeachBlock(cb func(Block)) {
+	b.eng.ForeachBlock(func(mb mol.Block) {
+		if mb, ok := mb.(*molBlock); ok {
+			cb(mb.block)
+		}
+	})
+}
+
+// ForeachObject calls cb with every object which has at least one block
+func (b *MolBackend) ForeachObject(cb func(PhysicsObject)) {
+	seen := make(map[*mol.Object]struct{})
+	b.eng.ForeachBlock(func(mb mol.Block) {
+		m, ok := mb.(*molBlock)
+		if !ok {
+			return
+		}
+		o := m.obj.Load()
+		if o == nil {
+			return
+		}
+		if _, ok := seen[o]; !ok {
+			seen[o] = struct{}{}
+			cb(wrapMolObject(o))
+		}
+	})
+}
+
+// molObject is a mol.Object which implements PhysicsObject, the conversion doesn't allocate
+type molObject mol.Object
+
+var _ PhysicsObject = (*molObject)(nil)
+
+// wrapMolObject returns nil for the nil object, so it can be compared with nil
+func wrapMolObject(o *mol.Object) PhysicsObject {
+	if o == nil {
+		return nil
+	}
+	return (*molObject)(o)
+}
+
+func unwrapMolObject(o PhysicsObject) *mol.Object {
+	if o == nil {
+		return nil
+	}
+	return (*mol.Object)(o.(*molObject))
+}
+
+func (o *molObject) obj() *mol.Object {
+	return (*mol.Object)(o)
+}
+
+func (o *molObject) String() string {
+	return o.obj().String()
+}
+
+func (o *molObject) Id() string {
+	return o.obj().Id().String()
+}
+
+func (o *molObject) Anchor() PhysicsObject {
+	return wrapMolObject(o.obj().Anchor())
+}
+
+func (o *molObject) AnchorLocked() PhysicsObject {
+	return wrapMolObject(o.obj().AnchorLocked())
+}
+
+func (o *molObject) Pos() mol.Vec3 {
+	return o.obj().Pos()
+}
+
+func (o *molObject) PosLocked() mol.Vec3 {
+	return o.obj().PosLocked()
+}
+
+func (o *molObject) AbsPos() mol.Vec3 {
+	return o.obj().AbsPos()
+}
+
+func (o *molObject) AbsPosLocked() mol.Vec3 {
+	return o.obj().AbsPosLocked()
+}
+
+func (o *molObject) Velocity() mol.Vec3 {
+	return o.obj().Velocity()
+}
+
+func (o *molObject) VelocityLocked() mol.Vec3 {
+	return o.obj().VelocityLocked()
+}
+
+func (o *molObject) SetPos(pos mol.Vec3) {
+	o.obj().SetPos(pos)
+}
+
+func (o *molObject) SetVelocity(vel mol.Vec3) {
+	o.obj().SetVelocity(vel)
+}
+
+func (o *molObject) AttachTo(anchor PhysicsObject) {
+	o.obj().AttachTo(unwrapMolObject(anchor))
+}
+
+func (o *molObject) SetRadius(radius float64) {
+	o.obj().SetRadius(radius)
+}
+
+func (o *molObject) FillGfields() {
+	o.obj().FillGfields()
+}
+
+func (o *molObject) AddBlock(b Block) {
+	o.obj().AddBlock(&molBlock{block: b})
+}
+
+// molBlock adapts a Block to mol.Block.
+// The block's Outline and Material are used if it has them.
+type molBlock struct {
+	block Block
+	obj   atomic.Pointer[mol.Object]
+}
+
+var _ mol.Block = (*molBlock)(nil)
+
+var emptyOutline = mol.NewCubeFromCenter(mol.Vec3{})
+
+func (b *molBlock) SetObject(o *mol.Object) {
+	b.obj.Store(o)
+	b.block.SetObject(wrapMolObject(o))
+}
+
+func (b *molBlock) Mass() float64 {
+	return b.block.Mass()
+}
+
+func (b *molBlock) Material(f mol.Facing) *mol.Material {
+	if m, ok := b.block.(interface {
+		Material(mol.Facing) *mol.Material
+	}); ok {
+		return m.Material(f)
+	}
+	return nil
+}
+
+func (b *molBlock) Outline() *mol.Cube {
+	if m, ok := b.block.(interface{ Outline() *mol.Cube }); ok {
+		return m.Outline()
+	}
+	return emptyOutline
+}
+
+func (b *molBlock) Tick(dt float64) {
+	b.block.Tick(dt)
+}
@@ -0,0 +1,371 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	mol "github.com/LiterMC/molecular"
+)
+
+// Integrator is the numerical integrator of the NBodyBackend
+type Integrator int
+
+const (
+	// IntegratorLeapfrog is the kick-drift-kick leapfrog, it's symplectic and second order
+	IntegratorLeapfrog Integrator = iota
+	// IntegratorEuler is the semi-implicit Euler, it's symplectic and first order
+	IntegratorEuler
+	// IntegratorRK4 is the classic Runge-Kutta, it's fourth order but not symplectic
+	IntegratorRK4
+)
+
+var integratorNames = []string{
+	IntegratorLeapfrog: "leapfrog",
+	IntegratorEuler:    "euler",
+	IntegratorRK4:      "rk4",
+}
+
+func (i Integrator) String() string {
+	if i >= 0 && (int)(i) < len(integratorNames) {
+		return integratorNames[i]
+	}
+	return "Integrator(" + strconv.Itoa((int)(i)) + ")"
+}
+
+func ParseIntegrator(name string) (Integrator, error) {
+	for i, n := range integratorNames {
+		if n == name {
+			return (Integrator)(i), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown integrator %q", name)
+}
+
+// NBodyBackend is a simple built-in PhysicsBackend which only simulates gravity.
+// Every object is attracted by the objects which filled their gravity fields, and the anchors never change.
+// Inside an attracting object, the gravity is the gravity of a uniform sphere, so there is no singularity.
+type NBodyBackend struct {
+	mux     sync.RWMutex
+	objects []*nbodyObject
+	lastID  int
+	events  atomic.Int64
+
+	// buffers of Tick
+	pos, vel, acc []mol.Vec3
+	k             [4][2][]mol.Vec3
+
+	// configs
+	Integrator Integrator
+	G          float64       // gravitational constant
+	MaxStep    time.Duration // the ticks are split into steps which are not longer than it
+}
+
+var _ PhysicsBackend = (*NBodyBackend)(nil)
+
+func NewNBodyBackend() (b *NBodyBackend) {
+	b = new(NBodyBackend)
+	b.Integrator = IntegratorLeapfrog
+	b.G = gravConst
+	b.MaxStep = time.Second
+	return
+}
+
+// NewObject calls cb with the backend locked before the object is added to the backend
+func (b *NBodyBackend) NewObject(typ ObjectType, anchor PhysicsObject, pos mol.Vec3, cb func(PhysicsObject)) PhysicsObject {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+	b.lastID++
+	o := &nbodyObject{
+		b:   b,
+		id:  b.lastID,
+		typ: typ,
+		pos: pos,
+	}
+	if anchor != nil {
+		o.anchor = anchor.(*nbodyObject)
+	}
+	if cb != nil {
+		cb(o)
+	}
+	b.objects = append(b.objects, o)
+	return o
+}
+
+// SetIntegrator changes the integrator between the ticks
+func (b *NBodyBackend) SetIntegrator(i Integrator) {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+	b.Integrator = i
+}
+
+func (b *NBodyBackend) Events() int {
+	return (int)(b.events.Load())
+}
+
+func (b *NBodyBackend) ForeachObject(cb func(PhysicsObject)) {
+	b.mux.RLock()
+	objects := make([]*nbodyObject, len(b.objects))
+	copy(objects, b.objects)
+	b.mux.RUnlock()
+	for _, o := range objects {
+		cb(o)
+	}
+}
+
+func (b *NBodyBackend) ForeachBlock(cb func(Block)) {
+	b.mux.RLock()
+	var blocks []Block
+	for _, o := range b.objects {
+		blocks = append(blocks, o.blocks...)
+	}
+	b.mux.RUnlock()
+	for _, bl := range blocks {
+		cb(bl)
+	}
+}
+
+// Tick advances the simulation, the absolute states are integrated and then converted back to the anchors.
+// The blocks are ticked while the backend is locked.
+func (b *NBodyBackend) Tick(dt time.Duration) {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+
+	n := len(b.objects)
+	if n == 0 || dt <= 0 {
+		b.events.Store(0)
+		return
+	}
+	b.pos = resizeVecs(b.pos, n)
+	b.vel = resizeVecs(b.vel, n)
+	b.acc = resizeVecs(b.acc, n)
+	masses := make([]float64, n)
+	radii := make([]float64, n)
+	for i, o := range b.objects {
+		radii[i] = o.radius
+		o.index = i
+		b.pos[i], b.vel[i] = o.absPos(), o.absVel()
+		for _, bl := range o.blocks {
+			masses[i] += bl.Mass()
+		}
+	}
+	var sources []int
+	for i, o := range b.objects {
+		if o.gfields && masses[i] > 0 {
+			sources = append(sources, i)
+		}
+	}
+	accel := func(pos, acc []mol.Vec3) {
+		for i := range acc {
+			acc[i] = mol.Vec3{}
+			for _, j := range sources {
+				if j == i {
+					continue
+				}
+				d := pos[j].Subbed(pos[i])
+				r2 := vecDot(d, d)
+				if r2 == 0 {
+					continue
+				}
+				if rad := radii[j]; r2 < rad*rad {
+					acc[i].Add(vecScaled(d, b.G*masses[j]/(rad*rad*rad)))
+				} else {
+					acc[i].Add(vecScaled(d, b.G*masses[j]/(r2*math.Sqrt(r2))))
+				}
+			}
+		}
+	}
+
+	steps := 1
+	if b.MaxStep > 0 {
+		steps = (int)((dt + b.MaxStep - 1) / b.MaxStep)
+	}
+	h := dt.Seconds() / (float64)(steps)
+	for s := 0; s < steps; s++ {
+		switch b.Integrator {
+		case IntegratorEuler:
+			accel(b.pos, b.acc)
+			for i := range b.pos {
+				b.vel[i].Add(vecScaled(b.acc[i], h))
+				b.pos[i].Add(vecScaled(b.vel[i], h))
+			}
+		case IntegratorRK4:
+			b.stepRK4(h, accel)
+		default:
+			accel(b.pos, b.acc)
+			for i := range b.pos {
+				b.vel[i].Add(vecScaled(b.acc[i], h/2))
+				b.pos[i].Add(vecScaled(b.vel[i], h))
+			}
+			accel(b.pos, b.acc)
+			for i := range b.pos {
+				b.vel[i].Add(vecScaled(b.acc[i], h/2))
+			}
+		}
+	}
+
+	// the relative states are calculated from the new absolute states of the anchors
+	for i, o := range b.objects {
+		var ap, av mol.Vec3
+		if a := o.anchor; a != nil {
+			ap, av = b.pos[a.index], b.vel[a.index]
+		}
+		o.pos, o.vel = b.pos[i].Subbed(ap), b.vel[i].Subbed(av)
+	}
+	for _, o := range b.objects {
+		for _, bl := range o.blocks {
+			bl.Tick(dt.Seconds())
+		}
+	}
+	b.events.Store((int64)(steps))
+}
+
+func (b *NBodyBackend) stepRK4(h float64, accel func(pos, acc []mol.Vec3)) {
+	n := len(b.pos)
+	for s := range b.k {
+		b.k[s][0] = resizeVecs(b.k[s][0], n)
+		b.k[s][1] = resizeVecs(b.k[s][1], n)
+	}
+	// k[s][0] is the derivative of the position, and k[s][1] is the derivative of the velocity
+	tmp := b.acc
+	offsets := [4]float64{0, h / 2, h / 2, h}
+	for s := range b.k {
+		kx, kv := b.k[s][0], b.k[s][1]
+		for i := range tmp {
+			tmp[i], kx[i] = b.pos[i], b.vel[i]
+			if s > 0 {
+				tmp[i].Add(vecScaled(b.k[s-1][0][i], offsets[s]))
+				kx[i].Add(vecScaled(b.k[s-1][1][i], offsets[s]))
+			}
+		}
+		accel(tmp, kv)
+	}
+	for i := range b.pos {
+		for s, w := range [4]float64{1, 2, 2, 1} {
+			b.pos[i].Add(vecScaled(b.k[s][0][i], h*w/6))
+			b.vel[i].Add(vecScaled(b.k[s][1][i], h*w/6))
+		}
+	}
+}
+
+func resizeVecs(v []mol.Vec3, n int) []mol.Vec3 {
+	if cap(v) < n {
+		return make([]mol.Vec3, n)
+	}
+	return v[:n]
+}
+
+type nbodyObject struct {
+	b       *NBodyBackend
+	id      int
+	typ     ObjectType
+	anchor  *nbodyObject
+	pos     mol.Vec3
+	vel     mol.Vec3
+	radius  float64
+	gfields bool
+	blocks  []Block
+	index   int // index in the backend while ticking
+}
+
+var _ PhysicsObject = (*nbodyObject)(nil)
+
+func (o *nbodyObject) String() string {
+	return fmt.Sprintf("<nbody object %d>", o.id)
+}
+
+func (o *nbodyObject) Id() string {
+	return strconv.Itoa(o.id)
+}
+
+func (o *nbodyObject) absPos() mol.Vec3 {
+	pos := o.pos
+	for a := o.anchor; a != nil; a = a.anchor {
+		pos.Add(a.pos)
+	}
+	return pos
+}
+
+func (o *nbodyObject) absVel() mol.Vec3 {
+	vel := o.vel
+	for a := o.anchor; a != nil; a = a.anchor {
+		vel.Add(a.vel)
+	}
+	return vel
+}
+
+func (o *nbodyObject) Anchor() PhysicsObject {
+	if o.anchor == nil {
+		return nil
+	}
+	return o.anchor
+}
+
+func (o *nbodyObject) AnchorLocked() PhysicsObject {
+	o.b.mux.RLock()
+	defer o.b.mux.RUnlock()
+	return o.Anchor()
+}
+
+func (o *nbodyObject) Pos() mol.Vec3 {
+	return o.pos
+}
+
+func (o *nbodyObject) PosLocked() mol.Vec3 {
+	o.b.mux.RLock()
+	defer o.b.mux.RUnlock()
+	return o.pos
+}
+
+func (o *nbodyObject) AbsPos() mol.Vec3 {
+	return o.absPos()
+}
+
+func (o *nbodyObject) AbsPosLocked() mol.Vec3 {
+	o.b.mux.RLock()
+	defer o.b.mux.RUnlock()
+	return o.absPos()
+}
+
+func (o *nbodyObject) Velocity() mol.Vec3 {
+	return o.vel
+}
+
+func (o *nbodyObject) VelocityLocked() mol.Vec3 {
+	o.b.mux.RLock()
+	defer o.b.mux.RUnlock()
+	return o.vel
+}
+
+func (o *nbodyObject) SetPos(pos mol.Vec3) {
+	o.pos = pos
+}
+
+func (o *nbodyObject) SetVelocity(vel mol.Vec3) {
+	o.vel = vel
+}
+
+// AttachTo changes the anchor, the relative position and velocity are not changed
+func (o *nbodyObject) AttachTo(anchor PhysicsObject) {
+	if anchor == nil {
+		o.anchor = nil
+		return
+	}
+	o.anchor = anchor.(*nbodyObject)
+}
+
+func (o *nbodyObject) SetRadius(radius float64) {
+	o.radius = radius
+}
+
+func (o *nbodyObject) FillGfields() {
+	o.gfields = true
+}
+
+func (o *nbodyObject) AddBlock(bl Block) {
+	o.blocks = append(o.blocks, bl)
+	bl.SetObject(o)
+}
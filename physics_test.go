@@ -0,0 +1,148 @@
+package main
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	mol "github.com/LiterMC/molecular"
+)
+
+type massBlock struct {
+	mass   float64
+	object PhysicsObject
+}
+
+func (b *massBlock) SetObject(o PhysicsObject) { b.object = o }
+func (b *massBlock) Mass() float64             { return b.mass }
+func (b *massBlock) Tick(dt float64)           {}
+func (b *massBlock) Object() PhysicsObject     { return b.object }
+
+// changeBlock applies the changes which are queued by the tests in its Tick
+type changeBlock struct {
+	massBlock
+	ChangeQueue
+}
+
+func (b *changeBlock) Tick(dt float64) { b.apply(b.object) }
+
+func TestNBodyCircularOrbit(t *testing.T) {
+	const (
+		mass   = 5.972e24
+		radius = 7e6
+	)
+	speed := math.Sqrt(gravConst * mass / radius)
+	period := (time.Duration)(2 * math.Pi * radius / speed * float64(time.Second))
+
+	for _, tc := range []struct {
+		integrator Integrator
+		tolerance  float64 // relative error of the radius after one period
+	}{
+		{IntegratorEuler, 1e-2},
+		{IntegratorLeapfrog, 1e-4},
+		{IntegratorRK4, 1e-6},
+	} {
+		t.Run(tc.integrator.String(), func(t *testing.T) {
+			b := NewNBodyBackend()
+			b.Integrator = tc.integrator
+			center := b.NewObject(NaturalObject, nil, mol.Vec3{}, func(o PhysicsObject) {
+				o.AddBlock(&massBlock{mass: mass})
+				o.FillGfields()
+			})
+			sat := b.NewObject(LivingObject, center, mol.Vec3{radius, 0, 0}, func(o PhysicsObject) {
+				o.AddBlock(&massBlock{mass: 1})
+				o.SetVelocity(mol.Vec3{0, 0, speed})
+			})
+			for elapsed := time.Duration(0); elapsed < period; elapsed += time.Minute {
+				b.Tick(min(time.Minute, period-elapsed))
+			}
+			if got := sat.AnchorLocked(); got != center {
+				t.Fatalf("anchor changed to %v", got)
+			}
+			r := sat.PosLocked().Len()
+			if err := math.Abs(r-radius) / radius; err > tc.tolerance {
+				t.Errorf("radius drifted by %.3g, expected less than %g", err, tc.tolerance)
+			}
+		})
+	}
+}
+
+func TestNBodyAnchoredStates(t *testing.T) {
+	b := NewNBodyBackend()
+	parent := b.NewObject(NaturalObject, nil, mol.Vec3{}, func(o PhysicsObject) {
+		o.SetVelocity(mol.Vec3{1, 0, 0})
+	})
+	child := b.NewObject(NaturalObject, parent, mol.Vec3{0, 5, 0}, func(o PhysicsObject) {
+		o.SetVelocity(mol.Vec3{0, 0, 2})
+	})
+	b.Tick(10 * time.Second)
+
+	// nothing has gravity, so every object moves in a straight line
+	if got, want := parent.AbsPosLocked(), (mol.Vec3{10, 0, 0}); got != want {
+		t.Errorf("parent is at %v, expected %v", got, want)
+	}
+	if got, want := child.AbsPosLocked(), (mol.Vec3{10, 5, 20}); got != want {
+		t.Errorf("child is at %v, expected %v", got, want)
+	}
+	if got, want := child.PosLocked(), (mol.Vec3{0, 5, 20}); got != want {
+		t.Errorf("child is at %v relative to the parent, expected %v", got, want)
+	}
+	if got, want := b.Events(), 10; got != want {
+		t.Errorf("ticked %d steps, expected %d", got, want)
+	}
+}
+
+// setterBlock changes its object in the first Tick, where the setters are allowed
+type setterBlock struct {
+	massBlock
+	anchor PhysicsObject
+	ticks  int
+}
+
+func (b *setterBlock) Tick(dt float64) {
+	b.ticks++
+	if b.ticks == 1 {
+		b.object.AttachTo(b.anchor)
+		b.object.SetVelocity(vecAdded(b.object.Velocity(), mol.Vec3{1, 0, 0}))
+	}
+}
+
+func TestBlockTickSetters(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		backend func() PhysicsBackend
+	}{
+		{"nbody", func() PhysicsBackend { return NewNBodyBackend() }},
+		{"molecular", func() PhysicsBackend { return NewMolBackend(mol.Config{}) }},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			b := tc.backend()
+			anchor := b.NewObject(NaturalObject, nil, mol.Vec3{}, func(o PhysicsObject) {
+				o.AddBlock(&massBlock{mass: 1})
+			})
+			block := &setterBlock{massBlock: massBlock{mass: 1}, anchor: anchor}
+			o := b.NewObject(LivingObject, nil, mol.Vec3{1e3, 0, 0}, func(o PhysicsObject) {
+				o.AddBlock(block)
+			})
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				b.Tick(time.Second)
+			}()
+			select {
+			case <-done:
+			case <-time.After(5 * time.Second):
+				t.Fatal("Tick deadlocked when the block called the setters")
+			}
+			if block.ticks == 0 {
+				t.Fatal("the block is not ticked")
+			}
+			if got := o.AnchorLocked(); got != anchor {
+				t.Errorf("anchor is %v, expected %v", got, anchor)
+			}
+			if got, want := o.VelocityLocked(), (mol.Vec3{1, 0, 0}); got != want {
+				t.Errorf("velocity is %v, expected %v", got, want)
+			}
+		})
+	}
+}
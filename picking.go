@@ -0,0 +1,169 @@
+package main
+
+import (
+	"math"
+
+	"github.com/LiterMC/curve/units"
+	mol "github.com/LiterMC/molecular"
+	"github.com/g3n/engine/gui"
+	"github.com/g3n/engine/math32"
+	"github.com/g3n/engine/window"
+)
+
+// PickRay returns the nearest body hit by the ray, or nil if nothing is hit.
+// Every body is treated as a bounding sphere which is at least minAngle radians wide
+// when seen from the ray's origin, so far away or tiny bodies can still be picked.
+func (bs *Bodies) PickRay(f *FrameState, origin, dir mol.Vec3, minAngle float64) (hit *Body, dist float64) {
+	dist = math.Inf(1)
+	for _, b := range bs.list {
+		toCenter := f.AbsPos(b.Object).Subbed(origin)
+		t := vecDot(toCenter, dir)
+		if t <= 0 {
+			continue
+		}
+		radius := math.Max(b.Radius, t*math.Tan(minAngle))
+		d2 := vecDot(toCenter, toCenter) - t*t
+		if d2 > radius*radius {
+			continue
+		}
+		if enter := t - math.Sqrt(radius*radius-d2); enter < dist {
+			hit, dist = b, enter
+		}
+	}
+	return
+}
+
+// Picker casts rays from the cursor to select bodies in the scene
+type Picker struct {
+	r *Runner
+
+	cursorX, cursorY float32
+	hover            *Body
+	menuObject       PhysicsObject
+
+	tooltip *gui.Label
+	menu    *gui.Menu
+
+	// configs
+	PickPixels float32 // how many pixels the smallest pickable body takes
+}
+
+func NewPicker(r *Runner) (pk *Picker) {
+	pk = new(Picker)
+	pk.r = r
+	pk.PickPixels = 6
+
+	pk.tooltip = gui.NewLabel("")
+	pk.tooltip.SetBgColor4(&math32.Color4{0.1, 0.1, 0.1, 0.7})
+	pk.tooltip.SetColor(&math32.Color{1, 1, 1})
+	pk.tooltip.SetPaddings(2, 4, 2, 4)
+	pk.tooltip.SetEnabled(false)
+	pk.tooltip.SetVisible(false)
+
+	pk.menu = gui.NewMenu()
+	pk.menu.AddOption("Set as target").Subscribe(gui.OnClick, func(evname string, ev any) {
+		r.targeting.SetTarget(pk.menuObject)
+		pk.CloseMenu()
+	})
+	pk.menu.AddOption("Show in map").Subscribe(gui.OnClick, func(evname string, ev any) {
+		r.mapView.SetTarget(pk.menuObject)
+		r.mapView.Open()
+		pk.CloseMenu()
+	})
+	pk.menu.AddSeparator()
+	pk.menu.AddOption("Cancel").Subscribe(gui.OnClick, func(evname string, ev any) {
+		pk.CloseMenu()
+	})
+	pk.menu.Subscribe(gui.OnMouseDownOut, func(evname string, ev any) {
+		pk.CloseMenu()
+	})
+	pk.menu.SetVisible(false)
+
+	mnr := gui.Manager()
+	mnr.SubscribeID(window.OnCursor, &pk, pk.onCursor)
+	mnr.SubscribeID(window.OnMouseDown, &pk, pk.onMouse)
+	return
+}
+
+func (pk *Picker) Dispose() {
+	mnr := gui.Manager()
+	mnr.UnsubscribeID(window.OnCursor, &pk)
+	mnr.UnsubscribeID(window.OnMouseDown, &pk)
+}
+
+// Tooltip returns the label which follows the hovered body
+func (pk *Picker) Tooltip() gui.IPanel {
+	return pk.tooltip
+}
+
+// Menu returns the context menu of the picked body
+func (pk *Picker) Menu() gui.IPanel {
+	return pk.menu
+}
+
+// Hover returns the body under the cursor, or under the crosshair when the cursor is captured
+func (pk *Picker) Hover() *Body {
+	return pk.hover
+}
+
+// PickAt returns the body at the window coordinates
+func (pk *Picker) PickAt(x, y float32) *Body {
+	origin, dir := pk.r.screenRay(x, y)
+	hit, _ := pk.r.bodies.PickRay(&pk.r.frame, origin, dir, pk.r.pixelAngle()*(float64)(pk.PickPixels)/2)
+	return hit
+}
+
+// OpenMenu shows the context menu of the object at the window coordinates
+func (pk *Picker) OpenMenu(o PhysicsObject, x, y float32) {
+	pk.menuObject = o
+	pk.menu.SetPosition(x, y)
+	pk.menu.SetVisible(true)
+}
+
+func (pk *Picker) CloseMenu() {
+	pk.menuObject = nil
+	pk.menu.SetVisible(false)
+}
+
+func (pk *Picker) pickPos() (x, y float32) {
+	if pk.r.player.ctrl.Focused() {
+		w, h := pk.r.GetSize()
+		return (float32)(w) / 2, (float32)(h) / 2
+	}
+	return pk.cursorX, pk.cursorY
+}
+
+func (pk *Picker) update() {
+	x, y := pk.pickPos()
+	pk.hover = pk.PickAt(x, y)
+	if pk.hover == nil || pk.menu.Visible() {
+		pk.tooltip.SetVisible(false)
+		return
+	}
+	dist := pk.r.frame.AbsPos(pk.hover.Object).Subbed(pk.r.frame.AbsPos(pk.r.playerObj)).Len()
+	pk.tooltip.SetText(pk.r.world.Name(pk.hover.Object) + "\n" + units.Distance(dist))
+	pk.tooltip.SetPosition(x+12, y+12)
+	pk.tooltip.SetVisible(true)
+}
+
+func (pk *Picker) onCursor(evname string, ev any) {
+	cev := ev.(*window.CursorEvent)
+	pk.cursorX, pk.cursorY = cev.Xpos, cev.Ypos
+}
+
+func (pk *Picker) onMouse(evname string, ev any) {
+	mev := ev.(*window.MouseEvent)
+	if mev.Button != window.MouseButtonRight {
+		return
+	}
+	if pk.r.player.ctrl.Focused() {
+		// select the body under the crosshair directly
+		if b := pk.PickAt(pk.pickPos()); b != nil {
+			pk.r.targeting.SetTarget(b.Object)
+		}
+		return
+	}
+	if b := pk.PickAt(mev.Xpos, mev.Ypos); b != nil {
+		pk.OpenMenu(b.Object, mev.Xpos, mev.Ypos)
+	}
+}
@@ -2,23 +2,34 @@ package main
 
 import (
 	"sync/atomic"
-	"time"
 
 	mol "github.com/LiterMC/molecular"
 	"github.com/g3n/engine/camera"
 	"github.com/g3n/engine/math32"
+	"github.com/g3n/engine/window"
 )
 
 var playerStandCube = mol.NewCube(mol.Vec3{-0.25, -1, -0.1}, mol.Vec3{0.5, 1.8, 0.2})
 var playerSneakCube = mol.NewCube(mol.Vec3{-0.25, -0.4, -0.2}, mol.Vec3{0.5, 0.9, 0.4})
 
 type Player struct {
-	ctrl    *FollowControl
-	outline *mol.Cube
+	ctrl     *FollowControl
+	input    Input
+	thruster ThrusterComponent
+	changes  ChangeQueue
+	outline  *mol.Cube
 
-	object *mol.Object
+	object PhysicsObject
 	queued atomic.Bool
 
+	// camera
+	Modes        [cameraModeCount]CameraModeConfig
+	mode         CameraMode
+	inputEnabled bool
+	viewReady    bool
+	viewPos      mol.Vec3
+	freePos      mol.Vec3
+
 	// status
 	enabled      FollowEnabled
 	status       followStatus
@@ -26,9 +37,11 @@ type Player struct {
 	lastX, lastY float32
 }
 
-func NewPlayer(cam *camera.Camera) (p *Player) {
+func NewPlayer(cam *camera.Camera, input Input) (p *Player) {
 	p = new(Player)
-	p.ctrl = NewFollowControl(cam)
+	p.input = input
+	p.thruster.Changes = &p.changes
+	p.ctrl = NewFollowControl(cam, input)
 	p.ctrl.OnMove = func(dist float32, direction *math32.Vector3) bool {
 		var quat math32.Quaternion
 		p.ctrl.Camera().WorldQuaternion(&quat)
@@ -36,23 +49,61 @@ func NewPlayer(cam *camera.Camera) (p *Player) {
 		dir.ApplyQuaternion(&quat)
 		dir.Normalize()
 		dir.MultiplyScalar(dist)
-		// Get world position
-		vel := p.object.VelocityLocked()
-		vel.Add(ToMolVec3(&dir))
-		p.object.SetVelocity(vel)
+		if p.mode == CameraFree {
+			// move the detached camera only
+			p.freePos.Add(ToMolVec3(&dir))
+			return true
+		}
+		p.thruster.Push(ToMolVec3(&dir))
 		return true
 	}
-	p.ctrl.SetEnabled(FollowRot | FollowZoom | FollowMove | FollowKeys)
 	p.outline = playerStandCube
 
+	p.Modes = defaultCameraModes()
+	p.inputEnabled = true
+	p.SetCameraMode(CameraFirstPerson)
+
 	p.enabled = FollowAll
+
+	input.SubscribeID(window.OnKeyDown, &p, p.onKey)
 	return
 }
 
-func (p *Player) SetObject(o *mol.Object) {
+func (p *Player) Dispose() {
+	p.input.UnsubscribeID(window.OnKeyDown, &p)
+	p.ctrl.Dispose()
+}
+
+// SetInputEnabled enables or disables the player's camera and movement controls
+func (p *Player) SetInputEnabled(enabled bool) {
+	p.inputEnabled = enabled
+	if enabled {
+		p.ctrl.SetEnabled(p.Modes[p.mode].Enabled)
+	} else {
+		p.ctrl.Pause()
+		p.ctrl.SetEnabled(FollowNone)
+	}
+}
+
+func (p *Player) onKey(evname string, ev any) {
+	if !p.inputEnabled {
+		return
+	}
+	kev := ev.(*window.KeyEvent)
+	switch kev.Key {
+	case window.KeyV:
+		p.CycleCameraMode()
+	}
+}
+
+func (p *Player) SetObject(o PhysicsObject) {
 	p.object = o
 }
 
+func (p *Player) Object() PhysicsObject {
+	return p.object
+}
+
 func (p *Player) Mass() float64 {
 	return 1e3
 }
@@ -65,19 +116,11 @@ func (p *Player) Outline() *mol.Cube {
 	return p.outline
 }
 
-func (p *Player) Tick(dt float64) {
+// Do queues a change of the player's object, it's applied in the next physics tick
+func (p *Player) Do(change func(o PhysicsObject)) {
+	p.changes.Do(change)
 }
 
-func (p *Player) renderTick(r *Runner, dt time.Duration) {
-	obj := p.object
-	pos := obj.AbsPosLocked()
-
-	r.stats.Speed = obj.VelocityLocked().Len()
-	r.stats.Pos = obj.PosLocked()
-	r.stats.Anchor = obj.AnchorLocked()
-
-	cam := p.ctrl.Camera()
-	p.ctrl.Tick(dt)
-	pos.ScaleN(1. / posScale)
-	cam.SetPosition(float32(pos.X), float32(pos.Y), float32(pos.Z))
+func (p *Player) Tick(dt float64) {
+	p.changes.apply(p.object)
 }
@@ -1,10 +1,15 @@
 package main
 
 import (
+	"errors"
 	"fmt"
-	"log"
+	"math"
+	"os"
+	"path/filepath"
+	"sync/atomic"
 	"time"
 
+	"github.com/LiterMC/curve/units"
 	mol "github.com/LiterMC/molecular"
 	"github.com/g3n/engine/app"
 	"github.com/g3n/engine/camera"
@@ -18,60 +23,115 @@ import (
 	"github.com/g3n/engine/window"
 )
 
-type Tickable interface {
-	Tick(dt time.Duration)
-}
-
 type Runner struct {
 	*app.Application
 	mainScene *core.Node
 	cam       *camera.Camera
 
+	settings     Settings
+	settingsPath string
+
 	// status
+	startTime     time.Time
 	lastFpsUpdate time.Time
-	frameCount int
+	frameCount    int
+	fps           int
+	tickTime      atomic.Int64  // duration of the last physics tick
+	simTime       time.Duration // simulated time, only accessed by the physics goroutine
+	timeWarp      atomic.Uint64 // bits of the float64 time warp factor
 	stats         guiStatus
 
-	intEng    *mol.Engine // internal physics engine
+	physics   PhysicsBackend
+	events    *EventBus
+	snapshots SnapshotBuffer
+	frame     FrameState // interpolated physics state of the current frame
 	player    *Player
-	playerObj *mol.Object
+	playerObj PhysicsObject
 	earth     core.INode
+	axes      core.INode
+
+	bodies       Bodies
+	geometries   *GeometryCache
+	world        *World
+	mapView      *MapView
+	targeting    *Targeting
+	picker       *Picker
+	labels       *BodyLabels
+	markers      *OrbitalMarkers
+	navball      *Navball
+	layout       *Layout
+	hud          *HUD
+	console      *Console
+	toasts       *Toasts
+	perf         *PerfStats
+	conservation *ConservationMonitor
+	perfView     *PerfOverlay
+	debugSrv     *DebugServer
+	assets       *Assets
 }
 
 type guiStatus struct {
-	Speed    float64
-	guiSpeed *gui.Label
-	Pos      mol.Vec3
-	guiPos   *gui.Label
-	Anchor   *mol.Object
-	guiAnchor *gui.Label
-	guiAnchorPos *gui.Label
+	Speed      float64
+	Pos        mol.Vec3
+	Anchor     PhysicsObject
+	AnchorName string
 }
 
-func (s *guiStatus) update() {
-	s.guiSpeed.SetText(fmt.Sprintf("%.2f m/s", s.Speed))
-	s.guiPos.SetText(fmt.Sprintf("%.1f, %.1f, %.1f", s.Pos.X, s.Pos.Y, s.Pos.Z))
-	s.guiAnchor.SetText(s.Anchor.Id().String())
-	s.guiAnchorPos.SetText((fmt.Sprintf("%.1f, %.1f, %.1f", s.Anchor.Pos().X, s.Anchor.Pos().Y, s.Anchor.Pos().Z)))
+// registerHUD registers the widgets of the player's status
+func (s *guiStatus) registerHUD(h *HUD, frame *FrameState) error {
+	return errors.Join(
+		h.Register("speed", "Speed:", func() (string, bool) {
+			return units.Speed(s.Speed), true
+		}),
+		h.Register("pos", "Pos:", func() (string, bool) {
+			return formatVec3(s.Pos), true
+		}),
+		h.Register("anchor", "Anchor:", func() (string, bool) {
+			return s.AnchorName, true
+		}),
+		h.Register("anchor.pos", "Anchor Pos:", func() (string, bool) {
+			if s.Anchor == nil {
+				return "", false
+			}
+			return formatVec3(frame.Pos(s.Anchor)), true
+		}),
+	)
+}
+
+func formatVec3(v mol.Vec3) string {
+	return fmt.Sprintf("%s, %s, %s", units.Distance(v.X), units.Distance(v.Y), units.Distance(v.Z))
 }
 
 func (r *Runner) initEngine(now time.Time) {
-	r.intEng = mol.NewEngine(mol.Config{})
+	physics, err := NewPhysicsBackend(r.settings.Physics)
+	if err != nil {
+		physicsLog.Error("cannot create physics backend, fallback to molecular", "err", err)
+		physics = NewMolBackend(mol.Config{})
+	}
+	r.physics = physics
 
+	watcher := newSimWatcher(r.events)
 	go func(last time.Time) {
-		ticker := time.NewTicker(time.Millisecond * 10)
+		ticker := time.NewTicker(physicsTickInterval)
 		defer ticker.Stop()
 		logc := 0
 		for {
 			select {
 			case t := <-ticker.C:
-				dt := t.Sub(last)
+				dt := (time.Duration)((float64)(t.Sub(last)) * r.TimeWarp())
 				start := time.Now()
-				r.intEng.Tick(dt)
+				r.physics.Tick(dt)
+				r.simTime += dt
+				snap := takeSnapshot(r.physics, t, r.simTime)
+				r.snapshots.Publish(snap)
+				watcher.tick(snap, objectRadii(r.physics))
+				r.conservation.tick(r.physics)
 				spt := time.Since(start)
+				r.tickTime.Store((int64)(spt))
+				r.perf.recordTick(t, spt, r.physics.Events())
 				if logc++; logc > 100 {
 					logc = 0
-					log.Println("Time per tick:", spt, "; events:", r.intEng.Events())
+					physicsLog.Debug("tick", "duration", spt, "events", r.physics.Events())
 				}
 				last = t
 			}
@@ -80,38 +140,132 @@ func (r *Runner) initEngine(now time.Time) {
 
 }
 
+// TimeWarp returns how many times faster the simulation runs than the real time
+func (r *Runner) TimeWarp() float64 {
+	return math.Float64frombits(r.timeWarp.Load())
+}
+
+// maxTimeWarp is the largest time warp, which keeps a physics tick at most 1000s of the simulation
+// and far from overflowing the tick's time.Duration
+const maxTimeWarp = 1e5
+
+// SetTimeWarp sets the time warp, which is clamped to [0, maxTimeWarp]. NaN is ignored.
+func (r *Runner) SetTimeWarp(warp float64) {
+	if math.IsNaN(warp) {
+		return
+	}
+	warp = min(max(warp, 0), maxTimeWarp)
+	old := math.Float64frombits(r.timeWarp.Swap(math.Float64bits(warp)))
+	if r.events != nil && old != warp {
+		r.events.Publish(OnTimeWarpChange, &TimeWarpEvent{Old: old, New: warp})
+	}
+}
+
 func (r *Runner) Init() (err error) {
 	now := time.Now()
 
 	r.SetTitle("Curve")
+	r.startTime = now
+	r.SetTimeWarp(1)
+	r.events = NewEventBus()
+	r.perf = NewPerfStats()
+	r.conservation = NewConservationMonitor()
+	r.loadSettings()
+	r.assets = NewAssets(resourcePackDir(r.settings.Assets, r.settingsPath))
+	r.startDebugServer()
 	r.initEngine(now)
 
-	log.Println("new scene")
+	renderLog.Debug("new scene")
 	scene := core.NewNode()
 	r.mainScene = scene
 
+	r.world = NewWorld()
 	r.cam = camera.NewPerspective(1, 0.01, 2*60*60*mol.C/posScale, 60, camera.Vertical)
-	r.player = NewPlayer(r.cam)
-	r.playerObj = r.intEng.NewObject(mol.LivingObj, nil, mol.Vec3{0, 0, 0}, func(player *mol.Object) {
+	r.player = NewPlayer(r.cam, NewWindowInput())
+	r.playerObj = r.physics.NewObject(LivingObject, nil, mol.Vec3{0, 0, 0}, func(player PhysicsObject) {
+		r.world.SetInfo(player, InfoComponent{
+			Name:        "Player",
+			Kind:        EntityShip,
+			Description: "You",
+		})
 		player.AddBlock(r.player)
+		id := r.world.Spawn(player)
+		AddComponent(r.world, id, &ControllerComponent{Control: r.player.ctrl})
+		AddComponent(r.world, id, &r.player.thruster)
 		player.SetVelocity(mol.Vec3{0, 0, 0})
 	})
-	log.Println("generating sun moon")
+	r.geometries = NewGeometryCache(0)
+	worldLog.Debug("generating sun moon")
 	r.initSunMoon()
 	scene.Add(r.cam)
+	r.layout = NewLayout()
+	r.layout.SetUIScale(r.settings.UIScale)
+	if system := units.ParseSystem(r.settings.Units); system != nil {
+		units.Default.System = system
+	} else {
+		uiLog.Warn("unknown unit system", "system", r.settings.Units)
+	}
+	r.hud = NewHUD(r, r.settings.HUD)
+	r.hud.OnChange = func(layout HUDLayout) {
+		r.settings.HUD = layout
+		r.saveSettings()
+	}
+	r.layout.OnScale(r.hud.SetScale)
+	if err := errors.Join(
+		r.stats.registerHUD(r.hud, &r.frame),
+		r.registerHUD(r.hud),
+		r.conservation.registerHUD(r.hud),
+	); err != nil {
+		uiLog.Error("cannot register the HUD widgets", "err", err)
+	}
+	r.mapView = NewMapView(r)
+	scene.Add(r.mapView.Node())
+	r.targeting = NewTargeting(r)
+	r.picker = NewPicker(r)
+	r.labels = NewBodyLabels(r)
+	r.markers = NewOrbitalMarkers(r)
+	r.navball = NewNavball(r)
+	r.layout.OnScale(r.navball.SetScale)
+	r.console = NewConsole(r)
+	r.toasts = NewToasts()
+	r.layout.OnScale(r.toasts.SetScale)
+	r.perfView = NewPerfOverlay(r)
+
+	r.lastFpsUpdate = now
+	r.initGUIs()
+	r.initSystems()
+	r.initEventLogging()
+	r.notifyAnchorChanges()
 	{
 		onResize := func(name string, value any) {
 			scaleX, scaleY := r.GetScale()
 			w, h := r.GetSize()
 			r.Gls().Viewport(0, 0, (int32)((float64)(w)*scaleX), (int32)((float64)(h)*scaleY))
 			r.cam.SetAspect((float32)(w) / (float32)(h))
+			r.layout.Resize((float32)(w), (float32)(h), r.uiScale())
 		}
 		onResize("", nil)
 		r.Subscribe(window.OnWindowSize, onResize)
 	}
-
-	r.lastFpsUpdate = now
-	r.initGUIs()
+	gui.Manager().Subscribe(window.OnKeyDown, func(evname string, ev any) {
+		kev := ev.(*window.KeyEvent)
+		switch kev.Key {
+		case window.KeyU:
+			r.setUnitSystem(units.CycleSystem())
+		case window.KeyEqual, window.KeyKPAdd:
+			if kev.Mods&window.ModControl != 0 {
+				r.setUIScale(r.layout.UIScale() + 0.25)
+			}
+		case window.KeyMinus, window.KeyKPSubtract:
+			if kev.Mods&window.ModControl != 0 {
+				r.setUIScale(r.layout.UIScale() - 0.25)
+			}
+		case window.Key0, window.KeyKP0:
+			if kev.Mods&window.ModControl != 0 {
+				r.setUIScale(1)
+			}
+		}
+	})
 
 	// Create and add a button to the scene
 	// btn := gui.NewButton("Make Red")
@@ -123,82 +277,174 @@ func (r *Runner) Init() (err error) {
 	// scene.Add(btn)
 
 	// Create and add lights to the scene
-	log.Println("add light")
+	renderLog.Debug("add light")
 	scene.Add(light.NewAmbient(&math32.Color{1.0, 1.0, 1.0}, 0.8))
 
-	scene.Add(helper.NewAxes(0))
+	r.axes = helper.NewAxes(0)
+	scene.Add(r.axes)
 
-	log.Println("set clear color")
+	renderLog.Debug("set clear color")
 	r.Gls().ClearColor(0.05, 0.05, 0.05, 1)
-	log.Println("done")
+	coreLog.Info("initialized")
+
+	r.initConsoleOverlays()
+	r.runStartupScript()
 
 	gui.Manager().Set(r.mainScene)
 	return
 }
 
-func (r *Runner) initGUIs() {
-	w, h := r.GetSize()
-	indicator, err := gui.NewImage("./assets/indicator.png")
+func (r *Runner) loadSettings() {
+	path, err := settingsPath()
 	if err != nil {
-		log.Panic(err)
+		coreLog.Warn("cannot locate settings file", "err", err)
+		r.settings = defaultSettings()
+		return
+	}
+	r.settingsPath = path
+	if r.settings, err = LoadSettings(path); err != nil {
+		coreLog.Error("cannot load settings", "path", path, "err", err)
 	}
+	r.settings.Log.apply(logging, filepath.Dir(path))
+}
+
+// startDebugServer starts the debug server if it's enabled by the settings or the CURVE_DEBUG_ADDR environment variable
+func (r *Runner) startDebugServer() {
+	addr := r.settings.Debug.Listen
+	if env := os.Getenv("CURVE_DEBUG_ADDR"); env != "" {
+		addr = env
+	}
+	if addr == "" {
+		return
+	}
+	ds, err := StartDebugServer(r, addr)
+	if err != nil {
+		coreLog.Error("cannot start debug server", "addr", addr, "err", err)
+		return
+	}
+	r.debugSrv = ds
+}
+
+func (r *Runner) saveSettings() {
+	if r.settingsPath == "" {
+		return
+	}
+	if err := r.settings.Save(r.settingsPath); err != nil {
+		coreLog.Error("cannot save settings", "path", r.settingsPath, "err", err)
+	}
+}
+
+func (r *Runner) setUnitSystem(system *units.System) {
+	units.Default.System = system
+	r.settings.Units = system.Name
+	r.saveSettings()
+	uiLog.Info("unit system changed", "system", system.Name)
+}
+
+func (r *Runner) setUIScale(scale float32) {
+	r.layout.SetUIScale(scale)
+	r.settings.UIScale = r.layout.UIScale()
+	r.saveSettings()
+	uiLog.Info("ui scale changed", "scale", r.settings.UIScale)
+}
+
+func (r *Runner) initConsoleOverlays() {
+	c := r.console
+	c.AddOverlay("labels", r.labels.Enabled, r.labels.SetEnabled)
+	c.AddOverlay("markers", r.markers.Enabled, r.markers.SetEnabled)
+	c.AddOverlay("navball", r.navball.Enabled, r.navball.SetEnabled)
+	c.AddOverlay("hud", r.hud.Enabled, r.hud.SetEnabled)
+	c.AddOverlay("axes", r.axes.Visible, r.axes.SetVisible)
+	c.AddOverlay("perf", r.perfView.Enabled, r.perfView.SetEnabled)
+}
+
+func (r *Runner) runStartupScript() {
+	path := r.settings.StartupScript
+	if path == "" {
+		return
+	}
+	if !filepath.IsAbs(path) {
+		if r.settingsPath == "" {
+			return
+		}
+		path = filepath.Join(filepath.Dir(r.settingsPath), path)
+	}
+	if _, err := os.Stat(path); err != nil {
+		return
+	}
+	consoleLog.Info("running startup script", "path", path)
+	if err := r.console.ExecFile(path); err != nil {
+		consoleLog.Error("startup script failed", "err", err)
+		r.console.Println("error:", err)
+	}
+}
+
+// registerHUD registers the widgets of the runner's clock
+func (r *Runner) registerHUD(h *HUD) error {
+	return errors.Join(
+		h.Register("time", "Time:", func() (string, bool) {
+			return units.Duration(time.Since(r.startTime).Seconds()), true
+		}),
+		h.Register("warp", "Time Warp:", func() (string, bool) {
+			warp := r.TimeWarp()
+			return fmt.Sprintf("x%g", warp), warp != 1
+		}),
+	)
+}
+
+func (r *Runner) initGUIs() {
+	indicator := gui.NewImageFromTex(r.assets.Texture("indicator.png"))
 	indicator.SetContentSize(9, 9)
-	indicator.SetPosition((float32)((w-9)/2), (float32)((h-9)/2))
 	r.mainScene.Add(indicator)
+	r.layout.Add(indicator, AnchorCenter, 0, 0)
 
-	statBox := gui.NewPanel(400, 22 * 10)
-	statBox.SetPosition(10, 10)
-	statBox.SetPaddings(5, 5, 5, 5)
-	statBox.SetColor4(&math32.Color4{0.7, 0.7, 0.7, 0.5})
-
-	speedLb := gui.NewLabel("Speed:")
-	statBox.Add(speedLb)
-	r.stats.guiSpeed = gui.NewLabel("")
-	r.stats.guiSpeed.SetPosition(speedLb.Width()+5, speedLb.Position().Y)
-	statBox.Add(r.stats.guiSpeed)
-
-	posLb := gui.NewLabel("Pos:")
-	posLb.SetPositionY(22)
-	statBox.Add(posLb)
-	r.stats.guiPos = gui.NewLabel("")
-	r.stats.guiPos.SetPosition(posLb.Width()+5, posLb.Position().Y)
-	statBox.Add(r.stats.guiPos)
-
-	anchorLb := gui.NewLabel("Anchor:")
-	anchorLb.SetPositionY(44)
-	statBox.Add(anchorLb)
-	r.stats.guiAnchor = gui.NewLabel("")
-	r.stats.guiAnchor.SetPosition(anchorLb.Width()+5, anchorLb.Position().Y)
-	statBox.Add(r.stats.guiAnchor)
-
-	anchorPosLb := gui.NewLabel("Anchor Pos:")
-	anchorPosLb.SetPositionY(66)
-	statBox.Add(anchorPosLb)
-	r.stats.guiAnchorPos = gui.NewLabel("")
-	r.stats.guiAnchorPos.SetPosition(anchorPosLb.Width()+5, anchorPosLb.Position().Y)
-	statBox.Add(r.stats.guiAnchorPos)
-
-	r.mainScene.Add(statBox)
+	r.mainScene.Add(r.hud.Panel())
+	r.layout.Place(r.hud.Panel(), AnchorTopLeft, 10, 10)
+	r.mainScene.Add(r.hud.Editor())
+	r.layout.Place(r.hud.Editor(), AnchorCenter, 0, 0)
+
+	r.mainScene.Add(r.targeting.Marker())
+
+	r.mainScene.Add(r.labels.Panel())
+	r.layout.Add(r.labels.Panel(), AnchorFill, 0, 0)
+	r.mainScene.Add(r.markers.Panel())
+	r.layout.Add(r.markers.Panel(), AnchorFill, 0, 0)
+	{
+		// the readouts are placed at the right side of the ball
+		nb := r.navball
+		pan := nb.Panel().GetPanel()
+		r.mainScene.Add(pan)
+		r.layout.Add(pan, AnchorBottom, nb.Size/2+10+pan.Width()/2, nb.BottomMargin+(nb.Size-pan.Height())/2)
+	}
+	r.mainScene.Add(r.mapView.Icons())
+	r.layout.Add(r.mapView.Icons(), AnchorFill, 0, 0)
+	r.mainScene.Add(r.picker.Tooltip())
+	r.mainScene.Add(r.picker.Menu())
+	r.mainScene.Add(r.perfView.Panel())
+	r.layout.Place(r.perfView.Panel(), AnchorTopRight, 10, 10)
+	r.mainScene.Add(r.toasts.Panel())
+	r.layout.Place(r.toasts.Panel(), AnchorTop, 0, 80)
+	r.mainScene.Add(r.console.Panel())
+	r.layout.Place(r.console.Panel(), AnchorTop, 0, 10)
 }
 
 func (r *Runner) Tick(rend *renderer.Renderer, dt time.Duration) {
 	now := time.Now()
 	if r.frameCount++; now.Sub(r.lastFpsUpdate) >= time.Second {
 		r.SetTitle(fmt.Sprintf("Curve | FPS: %d", r.frameCount))
+		r.fps = r.frameCount
 		r.lastFpsUpdate = now
 		r.frameCount = 0
 	}
+	// render one tick behind the physics, so there are always two snapshots around the frame
+	r.snapshots.Interpolate(now.Add(-physicsTickInterval), &r.frame)
+	r.world.Update(dt)
 
-	r.intEng.ForeachBlock(func(b mol.Block) {
-		if t, ok := b.(interface {
-			renderTick(r *Runner, dt time.Duration)
-		}); ok {
-			t.renderTick(r, dt)
-		}
-	})
-
-	r.stats.update()
+	// the content scale may change without resizing when the window is moved to another monitor
+	w, h := r.GetSize()
+	r.layout.Resize((float32)(w), (float32)(h), r.uiScale())
 
 	r.Gls().Clear(gls.DEPTH_BUFFER_BIT | gls.STENCIL_BUFFER_BIT | gls.COLOR_BUFFER_BIT)
 	rend.Render(r.mainScene, r.cam)
+	r.navball.render(rend)
 }
@@ -9,3 +9,14 @@ import (
 func (r *Runner) SetTitle(title string) {
 	r.Application.IWindow.(*window.GlfwWindow).SetTitle(title)
 }
+
+// uiScale returns the monitor's content scale which is not already covered by the framebuffer scale
+func (r *Runner) uiScale() float32 {
+	win := r.Application.IWindow.(*window.GlfwWindow)
+	sx, _ := win.GetContentScale()
+	fx, _ := win.GetScale()
+	if fx <= 0 {
+		return sx
+	}
+	return sx / (float32)(fx)
+}
@@ -4,14 +4,16 @@ package main
 
 import (
 	"syscall/js"
-
-	"github.com/g3n/engine/window"
 )
 
-const _ = panic("Wasm have problem right now")
-
 var document = js.Global().Get("document")
 
 func (r *Runner) SetTitle(title string) {
 	document.Set("title", title)
 }
+
+// uiScale returns the monitor's content scale which is not already covered by the framebuffer scale
+func (r *Runner) uiScale() float32 {
+	// the host page sizes the canvas in CSS pixels, which g3n reports the mouse positions in
+	return 1
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"math"
+
+	mol "github.com/LiterMC/molecular"
+	"github.com/g3n/engine/math32"
+)
+
+// projectToScreen projects the scene position to window coordinates.
+// ok will be false if the position is behind the camera or out of the depth range.
+func (r *Runner) projectToScreen(pos math32.Vector3) (x, y float32, ok bool) {
+	r.cam.Project(&pos)
+	if pos.Z < -1 || pos.Z > 1 {
+		return
+	}
+	w, h := r.GetSize()
+	x = (pos.X + 1) / 2 * (float32)(w)
+	y = (1 - pos.Y) / 2 * (float32)(h)
+	return x, y, true
+}
+
+// screenRay returns the ray in physics coordinates which starts from the camera's near plane
+// and passes through the window coordinates
+func (r *Runner) screenRay(x, y float32) (origin, dir mol.Vec3) {
+	w, h := r.GetSize()
+	ndcX := x/(float32)(w)*2 - 1
+	ndcY := 1 - y/(float32)(h)*2
+	near := math32.Vector3{ndcX, ndcY, -1}
+	far := math32.Vector3{ndcX, ndcY, 1}
+	r.cam.UpdateMatrixWorld()
+	r.cam.Unproject(&near)
+	r.cam.Unproject(&far)
+	far.Sub(&near)
+	origin = ToMolVec3(&near)
+	origin.ScaleN(posScale)
+	dir = vecNormalized(ToMolVec3(&far))
+	return
+}
+
+// pixelAngle returns the view angle covered by one pixel, in radians
+func (r *Runner) pixelAngle() float64 {
+	_, h := r.GetSize()
+	if h <= 0 {
+		return 0
+	}
+	return (float64)(r.cam.Fov()) * math.Pi / 180 / (float64)(h)
+}
+
+// cameraPos returns the camera's position in physics coordinates
+func (r *Runner) cameraPos() mol.Vec3 {
+	pos := r.cam.Position()
+	p := ToMolVec3(&pos)
+	p.ScaleN(posScale)
+	return p
+}
+
+// projectDirection projects a world direction seen from the camera to window coordinates.
+// If the direction is out of the view, the result is clamped to the window edge with the margin in pixels,
+// and onScreen will be false.
+func (r *Runner) projectDirection(dir mol.Vec3, margin float32) (x, y float32, onScreen bool) {
+	w, h := r.GetSize()
+	fw, fh := (float32)(w), (float32)(h)
+	d := ToG3NVec3(&dir)
+	d.Normalize()
+
+	// direction in the camera space, the camera looks at -Z
+	var quat math32.Quaternion
+	r.cam.WorldQuaternion(&quat)
+	quat.Inverse()
+	local := *d
+	local.ApplyQuaternion(&quat)
+
+	var ndcX, ndcY float32
+	if local.Z < 0 {
+		pos := r.cam.Position()
+		pos.Add(d)
+		r.cam.Project(&pos)
+		ndcX, ndcY = pos.X, pos.Y
+		if -1 <= ndcX && ndcX <= 1 && -1 <= ndcY && ndcY <= 1 {
+			return (ndcX + 1) / 2 * fw, (1 - ndcY) / 2 * fh, true
+		}
+	} else {
+		ndcX, ndcY = local.X, local.Y
+		if ndcX == 0 && ndcY == 0 {
+			ndcY = -1
+		}
+	}
+	// clamp to the edge along the direction from the screen center
+	halfW, halfH := fw/2-margin, fh/2-margin
+	px, py := ndcX*fw/2, -ndcY*fh/2
+	scale := math32.Min(halfW/math32.Abs(px), halfH/math32.Abs(py))
+	return fw/2 + px*scale, fh/2 + py*scale, false
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/LiterMC/curve/units"
+)
+
+// Settings are the user's preferences which persist between sessions
+type Settings struct {
+	UIScale float32   `json:"ui_scale"`
+	HUD     HUDLayout `json:"hud"`
+	Units   string    `json:"units"` // the name of the unit system
+	// StartupScript is the console script which runs after the game is loaded,
+	// the relative path is resolved from the settings file's directory
+	StartupScript string        `json:"startup_script"`
+	Log           LogSettings   `json:"log"`
+	Debug         DebugSettings `json:"debug"`
+	Assets        AssetSettings `json:"assets"`
+	// Physics is only applied after restarting
+	Physics PhysicsSettings `json:"physics"`
+}
+
+func defaultSettings() Settings {
+	return Settings{
+		UIScale:       1,
+		Units:         units.Astronomical.Name,
+		StartupScript: "startup.cfg",
+	}
+}
+
+// settingsPath returns the path of the settings file in the user's config directory
+func settingsPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "curve", "settings.json"), nil
+}
+
+// LoadSettings reads the settings file, the default settings are returned if the file does not exist
+func LoadSettings(path string) (s Settings, err error) {
+	s = defaultSettings()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			err = nil
+		}
+		return
+	}
+	if err = json.Unmarshal(data, &s); err != nil {
+		return defaultSettings(), err
+	}
+	return
+}
+
+// Save writes the settings file, the file is replaced atomically
+func (s *Settings) Save(path string) (err error) {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return
+	}
+	if err = os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return
+	}
+	tmp := path + ".tmp"
+	if err = os.WriteFile(tmp, data, 0644); err != nil {
+		return
+	}
+	return os.Rename(tmp, path)
+}
@@ -0,0 +1,152 @@
+package main
+
+import (
+	"sync/atomic"
+	"time"
+
+	mol "github.com/LiterMC/molecular"
+)
+
+// physicsTickInterval is the wall time between two physics ticks
+const physicsTickInterval = 10 * time.Millisecond
+
+// ObjectState is the state of an object at the end of a physics tick
+type ObjectState struct {
+	Anchor PhysicsObject
+	Pos    mol.Vec3 // relative to the anchor
+	AbsPos mol.Vec3
+	Vel    mol.Vec3 // relative to the anchor
+}
+
+// liveState reads the current state of the object from the engine
+func liveState(o PhysicsObject) ObjectState {
+	return ObjectState{
+		Anchor: o.AnchorLocked(),
+		Pos:    o.PosLocked(),
+		AbsPos: o.AbsPosLocked(),
+		Vel:    o.VelocityLocked(),
+	}
+}
+
+// Snapshot is the state of every tracked object after a physics tick.
+// It must not be modified after it's published.
+type Snapshot struct {
+	Time    time.Time     // wall time of the tick
+	SimTime time.Duration // simulated time since the engine started
+	Objects map[PhysicsObject]ObjectState
+	// Bodies are the objects which have an objectBlock, in the order of their blocks
+	Bodies []Body
+}
+
+// takeSnapshot records every object of the backend, it's called by the physics goroutine
+func takeSnapshot(eng PhysicsBackend, t time.Time, simTime time.Duration) *Snapshot {
+	s := &Snapshot{
+		Time:    t,
+		SimTime: simTime,
+		Objects: make(map[PhysicsObject]ObjectState),
+	}
+	eng.ForeachObject(func(o PhysicsObject) {
+		s.Objects[o] = liveState(o)
+	})
+	s.Bodies = collectBodies(eng)
+	return s
+}
+
+type snapshotPair struct {
+	prev, cur *Snapshot
+}
+
+// SnapshotBuffer keeps the two newest snapshots, the physics goroutine publishes
+// and the render loop reads without locking the engine
+type SnapshotBuffer struct {
+	pair atomic.Pointer[snapshotPair]
+}
+
+func (sb *SnapshotBuffer) Publish(s *Snapshot) {
+	var prev *Snapshot
+	if p := sb.pair.Load(); p != nil {
+		prev = p.cur
+	}
+	sb.pair.Store(&snapshotPair{prev: prev, cur: s})
+}
+
+// Latest returns the newest snapshot, or nil if nothing is published
+func (sb *SnapshotBuffer) Latest() *Snapshot {
+	if p := sb.pair.Load(); p != nil {
+		return p.cur
+	}
+	return nil
+}
+
+// Interpolate fills the frame with the states at the time between the two newest snapshots.
+// The time is clamped to the snapshots, so the states are never extrapolated.
+func (sb *SnapshotBuffer) Interpolate(t time.Time, f *FrameState) {
+	if f.objects == nil {
+		f.objects = make(map[PhysicsObject]ObjectState)
+	}
+	clear(f.objects)
+	p := sb.pair.Load()
+	if p == nil {
+		f.Time, f.SimTime = t, 0
+		return
+	}
+	cur, prev := p.cur, p.prev
+	if prev == nil || !cur.Time.After(prev.Time) {
+		f.Time, f.SimTime = cur.Time, cur.SimTime
+		for o, s := range cur.Objects {
+			f.objects[o] = s
+		}
+		return
+	}
+	alpha := (float64)(t.Sub(prev.Time)) / (float64)(cur.Time.Sub(prev.Time))
+	alpha = max(0, min(1, alpha))
+	f.Time = prev.Time.Add((time.Duration)(alpha * (float64)(cur.Time.Sub(prev.Time))))
+	f.SimTime = prev.SimTime + (time.Duration)(alpha*(float64)(cur.SimTime-prev.SimTime))
+	for o, s := range cur.Objects {
+		if ps, ok := prev.Objects[o]; ok {
+			s.AbsPos = vecLerp(ps.AbsPos, s.AbsPos, alpha)
+			// the relative states are not comparable after the anchor changed
+			if ps.Anchor == s.Anchor {
+				s.Pos = vecLerp(ps.Pos, s.Pos, alpha)
+				s.Vel = vecLerp(ps.Vel, s.Vel, alpha)
+			}
+		}
+		f.objects[o] = s
+	}
+}
+
+// FrameState is the interpolated physics state which is used by a render frame.
+// It never reads the engine, the objects which are not in the snapshots have the zero state.
+type FrameState struct {
+	Time    time.Time
+	SimTime time.Duration
+	objects map[PhysicsObject]ObjectState
+}
+
+func (f *FrameState) State(o PhysicsObject) ObjectState {
+	return f.objects[o]
+}
+
+func (f *FrameState) Anchor(o PhysicsObject) PhysicsObject {
+	return f.State(o).Anchor
+}
+
+func (f *FrameState) Pos(o PhysicsObject) mol.Vec3 {
+	return f.State(o).Pos
+}
+
+func (f *FrameState) AbsPos(o PhysicsObject) mol.Vec3 {
+	return f.State(o).AbsPos
+}
+
+func (f *FrameState) Velocity(o PhysicsObject) mol.Vec3 {
+	return f.State(o).Vel
+}
+
+// AbsVelocity returns the velocity of the object relative to the root anchor
+func (f *FrameState) AbsVelocity(o PhysicsObject) (vel mol.Vec3) {
+	for ; o != nil; o = f.Anchor(o) {
+		vel.Add(f.Velocity(o))
+	}
+	return
+}
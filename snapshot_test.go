@@ -0,0 +1,79 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	mol "github.com/LiterMC/molecular"
+)
+
+func TestSnapshotBodies(t *testing.T) {
+	nb := NewNBodyBackend()
+	planet := nb.NewObject(NaturalObject, nil, mol.Vec3{}, func(o PhysicsObject) {
+		o.AddBlock(&massBlock{mass: 1e6})
+		o.AddBlock(&massBlock{mass: 2e6})
+	})
+	ship := nb.NewObject(LivingObject, planet, mol.Vec3{1e3, 0, 0}, func(o PhysicsObject) {
+		o.AddBlock(&massBlock{mass: 10})
+	})
+	// an object without an objectBlock is not a body
+	nb.NewObject(NaturalObject, nil, mol.Vec3{}, nil)
+
+	now := time.Now()
+	var bodies Bodies
+	bodies.update(takeSnapshot(nb, now, 0))
+	list := bodies.List()
+	if len(list) != 2 {
+		t.Fatalf("got %d bodies, want 2", len(list))
+	}
+	for i, want := range []struct {
+		object PhysicsObject
+		mass   float64
+	}{
+		{planet, 3e6},
+		{ship, 10},
+	} {
+		b := list[i]
+		if b.Object != want.object || b.Mass != want.mass {
+			t.Errorf("body %d is %v with mass %g, want %v with mass %g", i, b.Object, b.Mass, want.object, want.mass)
+		}
+		if b.Color != defaultBodyColor {
+			t.Errorf("body %d has color %v, want the default color", i, b.Color)
+		}
+		if bodies.Get(b.Object) != b {
+			t.Errorf("body %d is not indexed", i)
+		}
+	}
+
+	// the bodies keep their identities between the snapshots, and the missing ones are removed
+	first := list[0]
+	s := takeSnapshot(nb, now.Add(time.Second), 0)
+	s.Bodies = s.Bodies[:1]
+	bodies.update(s)
+	if list := bodies.List(); len(list) != 1 || list[0] != first {
+		t.Errorf("got bodies %v, want only the first body", list)
+	}
+	if bodies.Get(ship) != nil {
+		t.Errorf("the removed body is still indexed")
+	}
+}
+
+func TestFrameStateWithoutSnapshot(t *testing.T) {
+	nb := NewNBodyBackend()
+	o := nb.NewObject(NaturalObject, nil, mol.Vec3{1, 2, 3}, func(o PhysicsObject) {
+		o.AddBlock(&massBlock{mass: 1})
+	})
+	var sb SnapshotBuffer
+	var f FrameState
+	sb.Interpolate(time.Now(), &f)
+	// the frame never reads the engine, so the object is absent until it's published
+	if got := f.State(o); got != (ObjectState{}) {
+		t.Errorf("got state %+v before the first snapshot, want the zero state", got)
+	}
+	now := time.Now()
+	sb.Publish(takeSnapshot(nb, now, 0))
+	sb.Interpolate(now, &f)
+	if got, want := f.AbsPos(o), (mol.Vec3{1, 2, 3}); got != want {
+		t.Errorf("got position %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,176 @@
+package main
+
+import (
+	"math"
+
+	mol "github.com/LiterMC/molecular"
+)
+
+// soiSamples is how many points of the trajectory are checked for the SOI transitions
+const soiSamples = 256
+
+// SOIRadius returns the radius of the sphere of influence by the Laplace approximation,
+// for a body of the mass orbiting a parent of parentMass at the distance.
+// It's infinite if the parent has no mass.
+func SOIRadius(dist, mass, parentMass float64) float64 {
+	if parentMass <= 0 {
+		return math.Inf(1)
+	}
+	return dist * math.Pow(mass/parentMass, 0.4)
+}
+
+// SOIChild is a body which orbits the same anchor as the trajectory
+type SOIChild struct {
+	Object PhysicsObject
+	Orbit  Orbit
+	Radius float64 // radius of the body's sphere of influence
+}
+
+// SOITransition is a predicted anchor change along a trajectory
+type SOITransition struct {
+	Time float64  // seconds from now
+	Pos  mol.Vec3 // position relative to the current anchor
+	// Exit reports whether the trajectory leaves the current anchor's sphere of influence,
+	// otherwise it enters the sphere of the Child
+	Exit  bool
+	Child PhysicsObject
+}
+
+// horizon returns the seconds to sample the trajectory for, which is a revolution of a closed orbit,
+// or until an open orbit leaves the sphere of influence, or is too far from the anchor if the sphere is infinite
+func (o *Orbit) horizon(soi float64) float64 {
+	if o.Bound() {
+		return o.Period()
+	}
+	r := soi
+	if math.IsInf(r, 0) {
+		r = maxHyperbolicDist * o.PeriapsisDist()
+	}
+	c := (o.SemiLatus/r - 1) / o.Eccentricity
+	if c < -1 || c > 1 {
+		return 0
+	}
+	return math.Max(0, o.TimeTo(math.Acos(c)))
+}
+
+// PredictSOITransition finds the first SOI transition along the orbit.
+// soi is the radius of the anchor's sphere of influence, and the children move along their own orbits.
+// The children which already contain the trajectory's start are ignored.
+func PredictSOITransition(orbit Orbit, soi float64, children []SOIChild) (tr SOITransition, ok bool) {
+	horizon := orbit.horizon(soi)
+	if horizon <= 0 || math.IsInf(horizon, 0) || math.IsNaN(horizon) {
+		return
+	}
+	// slightly past the horizon, so the exit point of an open orbit is reached
+	horizon *= 1.001
+
+	start := orbit.PosAfter(0)
+	candidates := make([]SOIChild, 0, len(children))
+	for _, c := range children {
+		if start.Subbed(c.Orbit.PosAfter(0)).Len() >= c.Radius {
+			candidates = append(candidates, c)
+		}
+	}
+	// check returns which transition has happened at the time, -1 is the exit and -2 is none
+	check := func(t float64) int {
+		pos := orbit.PosAfter(t)
+		if pos.Len() > soi {
+			return -1
+		}
+		for i, c := range candidates {
+			if pos.Subbed(c.Orbit.PosAfter(t)).Len() < c.Radius {
+				return i
+			}
+		}
+		return -2
+	}
+
+	step := horizon / soiSamples
+	for i := 1; i <= soiSamples; i++ {
+		t := step * (float64)(i)
+		hit := check(t)
+		if hit == -2 {
+			continue
+		}
+		lo, hi := t-step, t
+		for j := 0; j < 40; j++ {
+			mid := (lo + hi) / 2
+			if h := check(mid); h == -2 {
+				lo = mid
+			} else {
+				hi, hit = mid, h
+			}
+		}
+		tr.Time = hi
+		tr.Pos = orbit.PosAfter(hi)
+		if hit == -1 {
+			tr.Exit = true
+		} else {
+			tr.Child = candidates[hit].Object
+		}
+		return tr, true
+	}
+	return
+}
+
+// soiRadius returns the radius of the object's sphere of influence in the current frame
+func (r *Runner) soiRadius(o PhysicsObject) float64 {
+	state := r.frame.State(o)
+	body, parent := r.bodies.Get(o), r.bodies.Get(state.Anchor)
+	if body == nil || parent == nil {
+		return math.Inf(1)
+	}
+	return SOIRadius(state.Pos.Len(), body.Mass, parent.Mass)
+}
+
+// predictSOITransition predicts the next SOI transition of the object in the current frame
+func (r *Runner) predictSOITransition(o PhysicsObject) (tr SOITransition, ok bool) {
+	state := r.frame.State(o)
+	anchor := r.bodies.Get(state.Anchor)
+	if anchor == nil || anchor.Mass <= 0 {
+		return
+	}
+	mu := gravConst * anchor.Mass
+	orbit, ok := NewOrbit(state.Pos, state.Vel, mu)
+	if !ok {
+		return
+	}
+	var children []SOIChild
+	for _, b := range r.bodies.List() {
+		if b.Object == o || b.Mass <= 0 {
+			continue
+		}
+		cs := r.frame.State(b.Object)
+		if cs.Anchor != state.Anchor {
+			continue
+		}
+		corbit, ok := NewOrbit(cs.Pos, cs.Vel, mu)
+		if !ok {
+			continue
+		}
+		children = append(children, SOIChild{
+			Object: b.Object,
+			Orbit:  corbit,
+			Radius: SOIRadius(cs.Pos.Len(), b.Mass, anchor.Mass),
+		})
+	}
+	return PredictSOITransition(orbit, r.soiRadius(state.Anchor), children)
+}
+
+// notifyAnchorChanges shows a toast when the player moves to another sphere of influence
+func (r *Runner) notifyAnchorChanges() {
+	r.events.SubscribeID(OnAnchorChange, &r.toasts, func(evname string, ev any) {
+		e := ev.(*AnchorChangeEvent)
+		if e.Object != r.playerObj {
+			return
+		}
+		switch {
+		case e.New == nil:
+			r.toasts.Show("Left " + r.world.Name(e.Old) + "'s sphere of influence")
+		case e.Old != nil && r.frame.Anchor(e.Old) == e.New:
+			r.toasts.Show("Left " + r.world.Name(e.Old) + "'s sphere of influence, now orbiting " + r.world.Name(e.New))
+		default:
+			r.toasts.Show("Entered " + r.world.Name(e.New) + "'s sphere of influence")
+		}
+	})
+}
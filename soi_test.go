@@ -0,0 +1,119 @@
+package main
+
+import (
+	"math"
+	"testing"
+
+	mol "github.com/LiterMC/molecular"
+)
+
+func TestOrbitPropagation(t *testing.T) {
+	const radius = 7e6
+	mu := gravConst * earthMass
+	speed := math.Sqrt(mu / radius)
+
+	for _, tc := range []struct {
+		name string
+		vel  float64 // in multiples of the circular speed
+	}{
+		{"Circular", 1},
+		{"Elliptic", 1.3},
+		{"Hyperbolic", 1.6},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			pos := mol.Vec3{radius, 0, 0}
+			orbit, ok := NewOrbit(pos, mol.Vec3{0, 0, speed * tc.vel}, mu)
+			if !ok {
+				t.Fatal("orbit is degenerated")
+			}
+			if got := orbit.PosAfter(0); got.Subbed(pos).Len() > 1e-3 {
+				t.Errorf("position after 0s is %v, expected %v", got, pos)
+			}
+			// the time to an anomaly and the anomaly after the time are inverse
+			for _, nu := range []float64{0.3, 1, 2} {
+				if !orbit.Bound() && math.Cos(nu) <= -1/orbit.Eccentricity {
+					continue
+				}
+				dt := orbit.TimeTo(nu)
+				if got := orbit.TrueAnomalyAfter(dt); math.Abs(math.Remainder(got-nu, 2*math.Pi)) > 1e-9 {
+					t.Errorf("anomaly %.3f is reached after %gs, but the anomaly is %.12f then", nu, dt, got)
+				}
+			}
+			if orbit.Bound() {
+				if got := orbit.PosAfter(orbit.Period()); got.Subbed(pos).Len() > 1e-2 {
+					t.Errorf("position after a period is %v, expected %v", got, pos)
+				}
+			}
+		})
+	}
+
+	t.Run("QuarterCircle", func(t *testing.T) {
+		orbit, _ := NewOrbit(mol.Vec3{radius, 0, 0}, mol.Vec3{0, 0, speed}, mu)
+		got := orbit.PosAfter(orbit.Period() / 4)
+		if want := (mol.Vec3{0, 0, radius}); got.Subbed(want).Len() > 1e-3 {
+			t.Errorf("position after a quarter period is %v, expected %v", got, want)
+		}
+	})
+}
+
+func TestSOIRadius(t *testing.T) {
+	// the commonly quoted radius of Earth's sphere of influence is about 9.2e8 m
+	if got := SOIRadius(1.496e11, earthMass, sunMass); math.Abs(got-9.25e8)/9.25e8 > 0.01 {
+		t.Errorf("Earth's SOI radius is %g m", got)
+	}
+	if got := SOIRadius(1, 1, 0); !math.IsInf(got, 1) {
+		t.Errorf("SOI radius around a massless parent is %g, expected +Inf", got)
+	}
+}
+
+func TestPredictSOITransition(t *testing.T) {
+	mu := gravConst * earthMass
+	soi := SOIRadius(1.496e11, earthMass, sunMass)
+	moonDist := 3.844e8
+	moonOrbit, _ := NewOrbit(mol.Vec3{-moonDist, 0, 0}, mol.Vec3{0, 0, -math.Sqrt(mu / moonDist)}, mu)
+	moon := SOIChild{
+		Orbit:  moonOrbit,
+		Radius: SOIRadius(moonDist, moonMass, earthMass),
+	}
+
+	lowOrbit := func(speed float64) Orbit {
+		const radius = 7e6
+		o, _ := NewOrbit(mol.Vec3{radius, 0, 0}, mol.Vec3{0, 0, speed * math.Sqrt(mu/radius)}, mu)
+		return o
+	}
+
+	t.Run("LowOrbit", func(t *testing.T) {
+		if tr, ok := PredictSOITransition(lowOrbit(1), soi, []SOIChild{moon}); ok {
+			t.Errorf("a low circular orbit has a transition %+v", tr)
+		}
+	})
+	t.Run("Escape", func(t *testing.T) {
+		tr, ok := PredictSOITransition(lowOrbit(1.5), soi, nil)
+		if !ok || !tr.Exit {
+			t.Fatalf("the escape trajectory does not leave the SOI: %+v", tr)
+		}
+		if d := tr.Pos.Len(); math.Abs(d-soi)/soi > 1e-6 {
+			t.Errorf("the exit is %g m from Earth, expected the SOI radius %g m", d, soi)
+		}
+	})
+	t.Run("EnterMoon", func(t *testing.T) {
+		// a transfer orbit whose apoapsis is at the moon's distance, so the trajectory meets the moon
+		// at the apoapsis, half a period later
+		const radius = 7e6
+		a := (radius + moonDist) / 2
+		speed := math.Sqrt(mu * (2/radius - 1/a))
+		transfer, _ := NewOrbit(mol.Vec3{radius, 0, 0}, mol.Vec3{0, 0, -speed}, mu)
+		m := moon
+		m.Orbit.TrueAnomaly -= moon.Orbit.meanMotion() * transfer.Period() / 2
+		tr, ok := PredictSOITransition(transfer, soi, []SOIChild{m})
+		if !ok || tr.Exit {
+			t.Fatalf("the transfer does not enter the moon's SOI: %+v", tr)
+		}
+		if tr.Time >= transfer.Period()/2 {
+			t.Errorf("entered the moon's SOI after %gs, expected before the apoapsis at %gs", tr.Time, transfer.Period()/2)
+		}
+		if d := tr.Pos.Subbed(m.Orbit.PosAfter(tr.Time)).Len(); math.Abs(d-m.Radius)/m.Radius > 1e-6 {
+			t.Errorf("the entry is %g m from the moon, expected the SOI radius %g m", d, m.Radius)
+		}
+	})
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"time"
+)
+
+// initSystems adds the systems which run every frame, in the order of the stages:
+// events, input, physics sync, LOD and HUD
+func (r *Runner) initSystems() {
+	w := r.world
+
+	w.AddSystem("events", PriorityEvents, SystemFunc(func(dt time.Duration) {
+		// the events are delivered when the interpolated frame reaches their ticks
+		r.events.Flush(r.frame.Time)
+	}))
+
+	w.AddSystem("controllers", PriorityInput, SystemFunc(func(dt time.Duration) {
+		Each(w, func(_ EntityID, _ PhysicsObject, c *ControllerComponent) {
+			c.Control.Tick(dt)
+		})
+	}))
+	w.AddSystem("thrusters", PriorityInput, SystemFunc(func(dt time.Duration) {
+		Each(w, func(_ EntityID, _ PhysicsObject, t *ThrusterComponent) {
+			t.flush()
+		})
+	}))
+
+	w.AddSystem("meshes", PriorityPhysicsSync, SystemFunc(func(dt time.Duration) {
+		Each(w, func(_ EntityID, o PhysicsObject, m *MeshComponent) {
+			if o == nil {
+				return
+			}
+			pos := renderPos(r.frame.AbsPos(o))
+			m.Node.GetNode().SetPositionVec(&pos)
+		})
+	}))
+	w.AddSystem("camera", PriorityPhysicsSync, SystemFunc(func(dt time.Duration) {
+		r.player.updateView(&r.frame, r.bodies.Get(r.targeting.Target()), dt)
+	}))
+	w.AddSystem("stats", PriorityPhysicsSync, SystemFunc(func(dt time.Duration) {
+		r.updateStats()
+	}))
+
+	w.AddSystem("geometries", PriorityLOD, SystemFunc(func(dt time.Duration) {
+		r.geometries.update()
+	}))
+	w.AddSystem("lod", PriorityLOD, SystemFunc(func(dt time.Duration) {
+		camPos := r.cameraPos()
+		Each(w, func(_ EntityID, o PhysicsObject, m *MeshComponent) {
+			if m.LOD != nil && o != nil {
+				m.LOD(r.frame.AbsPos(o).Subbed(camPos).Len())
+			}
+		})
+	}))
+
+	w.AddSystem("bodies", PriorityHUD, SystemFunc(func(dt time.Duration) {
+		r.bodies.update(r.snapshots.Latest())
+		r.perf.recordFrame(time.Now(), dt, len(r.bodies.List()))
+	}))
+	w.AddSystem("map", PriorityHUD, SystemFunc(r.mapView.Tick))
+	w.AddSystem("targeting", PriorityHUD, SystemFunc(func(time.Duration) { r.targeting.update() }))
+	w.AddSystem("picker", PriorityHUD, SystemFunc(func(time.Duration) { r.picker.update() }))
+	w.AddSystem("labels", PriorityHUD, SystemFunc(func(time.Duration) { r.labels.update() }))
+	w.AddSystem("markers", PriorityHUD, SystemFunc(func(time.Duration) { r.markers.update() }))
+	w.AddSystem("navball", PriorityHUD, SystemFunc(func(time.Duration) { r.navball.update() }))
+	w.AddSystem("hud", PriorityHUD, SystemFunc(func(time.Duration) { r.hud.update() }))
+	w.AddSystem("toasts", PriorityHUD, SystemFunc(func(time.Duration) { r.toasts.update(time.Now()) }))
+	w.AddSystem("console", PriorityHUD, SystemFunc(func(time.Duration) { r.console.update() }))
+	w.AddSystem("perf", PriorityHUD, SystemFunc(func(time.Duration) { r.perfView.update(time.Now()) }))
+}
+
+// updateStats reads the player's state of the current frame for the HUD
+func (r *Runner) updateStats() {
+	state := r.frame.State(r.playerObj)
+	r.stats.Speed = state.Vel.Len()
+	r.stats.Pos = state.Pos
+	r.stats.Anchor = state.Anchor
+	r.stats.AnchorName = r.world.Name(r.stats.Anchor)
+}
@@ -0,0 +1,157 @@
+package main
+
+import (
+	"math"
+
+	"github.com/LiterMC/curve/units"
+	mol "github.com/LiterMC/molecular"
+	"github.com/g3n/engine/gui"
+	"github.com/g3n/engine/math32"
+	"github.com/g3n/engine/window"
+)
+
+// TargetInfo is the navigation data of a target relative to the player
+type TargetInfo struct {
+	Distance float64
+	// RelVelocity is the target's velocity relative to the player
+	RelVelocity mol.Vec3
+	// ClosingRate is positive when the target is getting closer
+	ClosingRate float64
+	// ApproachTime is the time until the closest approach assuming both keep their velocity,
+	// it's zero if the closest approach is already passed
+	ApproachTime float64
+	ApproachDist float64
+}
+
+// NewTargetInfo calculates the navigation data from the target's
+// position and velocity relative to the player
+func NewTargetInfo(relPos, relVel mol.Vec3) (info TargetInfo) {
+	info.Distance = relPos.Len()
+	info.RelVelocity = relVel
+	if info.Distance > 0 {
+		info.ClosingRate = -vecDot(relPos, relVel) / info.Distance
+	}
+	if v2 := vecDot(relVel, relVel); v2 > 0 {
+		info.ApproachTime = math.Max(0, -vecDot(relPos, relVel)/v2)
+	}
+	info.ApproachDist = vecAdded(relPos, vecScaled(relVel, info.ApproachTime)).Len()
+	return
+}
+
+const targetMarkerSize = 20
+
+// Targeting manages the player's selected target
+type Targeting struct {
+	r      *Runner
+	target PhysicsObject
+	info   TargetInfo
+
+	marker *gui.Panel
+}
+
+func NewTargeting(r *Runner) (t *Targeting) {
+	t = new(Targeting)
+	t.r = r
+
+	t.marker = gui.NewPanel(targetMarkerSize, targetMarkerSize)
+	t.marker.SetColor4(&math32.Color4{0, 0, 0, 0})
+	t.marker.SetBorders(2, 2, 2, 2)
+	t.marker.SetBordersColor(&math32.Color{1.0, 0.8, 0.0})
+	t.marker.SetEnabled(false)
+	t.marker.SetVisible(false)
+
+	addRow := func(id string, title string, value func() string) {
+		err := r.hud.Register(id, title, func() (string, bool) {
+			if t.target == nil {
+				return "", false
+			}
+			return value(), true
+		})
+		if err != nil {
+			uiLog.Error("cannot register the HUD widget", "err", err)
+		}
+	}
+	addRow("target", "Target:", func() string {
+		return r.world.Name(t.target)
+	})
+	addRow("target.distance", "Distance:", func() string {
+		return units.Distance(t.info.Distance)
+	})
+	addRow("target.relspeed", "Rel Speed:", func() string {
+		return units.Speed(t.info.RelVelocity.Len())
+	})
+	addRow("target.closing", "Closing:", func() string {
+		return units.Speed(t.info.ClosingRate)
+	})
+	addRow("target.approach", "Closest:", func() string {
+		return units.Distance(t.info.ApproachDist) + " in " + units.Duration(t.info.ApproachTime)
+	})
+
+	gui.Manager().SubscribeID(window.OnKeyDown, &t, t.onKey)
+	return
+}
+
+func (t *Targeting) Dispose() {
+	gui.Manager().UnsubscribeID(window.OnKeyDown, &t)
+}
+
+// Marker returns the on-screen marker of the target
+func (t *Targeting) Marker() gui.IPanel {
+	return t.marker
+}
+
+func (t *Targeting) Target() PhysicsObject {
+	return t.target
+}
+
+// SetTarget selects the target, nil clears the selection
+func (t *Targeting) SetTarget(o PhysicsObject) {
+	if o == t.r.playerObj {
+		o = nil
+	}
+	t.target = o
+	if o == nil {
+		t.marker.SetVisible(false)
+	}
+}
+
+// Cycle selects the next (or previous if step is negative) body as the target
+func (t *Targeting) Cycle(step int) {
+	if o := t.r.bodies.Next(t.target, step, t.r.playerObj); o != nil {
+		t.SetTarget(o)
+	}
+}
+
+func (t *Targeting) Info() TargetInfo {
+	return t.info
+}
+
+func (t *Targeting) update() {
+	if t.target == nil {
+		return
+	}
+	player, f := t.r.playerObj, &t.r.frame
+	relPos := f.AbsPos(t.target).Subbed(f.AbsPos(player))
+	relVel := f.AbsVelocity(t.target).Subbed(f.AbsVelocity(player))
+	t.info = NewTargetInfo(relPos, relVel)
+
+	x, y, ok := t.r.projectToScreen(renderPos(f.AbsPos(t.target)))
+	t.marker.SetVisible(ok)
+	if ok {
+		t.marker.SetPosition(x-targetMarkerSize/2, y-targetMarkerSize/2)
+	}
+}
+
+func (t *Targeting) onKey(evname string, ev any) {
+	kev := ev.(*window.KeyEvent)
+	switch kev.Key {
+	case window.KeyT:
+		if kev.Mods&window.ModShift != 0 {
+			t.Cycle(-1)
+		} else {
+			t.Cycle(1)
+		}
+	case window.KeyBackspace:
+		t.SetTarget(nil)
+	}
+}
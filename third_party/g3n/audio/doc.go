@@ -0,0 +1,7 @@
+// Copyright 2016 The G3N Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package audio contains sub packages for binding to external audio libraries and
+// implements a spatial audio player.
+package audio
@@ -0,0 +1,8 @@
+package core
+
+import (
+	"github.com/g3n/engine/util/logger"
+)
+
+// Package logger
+var log = logger.New("CORE", logger.Default)
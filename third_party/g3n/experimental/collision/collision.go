@@ -0,0 +1,16 @@
+// Copyright 2016 The G3N Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package collision implements collision related algorithms and data structures.
+// WARNING: Parts of this package are experimental and incomplete!
+package collision
+
+import "github.com/g3n/engine/geometry"
+
+// TODO collision checking function dependent on collision shapes
+
+func CheckConvex(g1, g2 *geometry.Geometry) bool {
+
+	return true
+}
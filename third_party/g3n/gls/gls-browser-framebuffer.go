@@ -0,0 +1,94 @@
+// Copyright 2016 The G3N Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build wasm
+// +build wasm
+
+// This file is added by Curve's fork,
+// it implements the framebuffer calls of renderer/postprocessor.go for WebGL.
+
+package gls
+
+import (
+	"syscall/js"
+)
+
+// GenFramebuffer creates a new framebuffer.
+func (gs *GLS) GenFramebuffer() uint32 {
+
+	gs.framebufferMap[gs.framebufferMapIndex] = gs.gl.Call("createFramebuffer")
+	gs.checkError("GenFramebuffer")
+	idx := gs.framebufferMapIndex
+	gs.framebufferMapIndex++
+	gs.stats.Fbos++
+	return idx
+}
+
+// GenRenderbuffer creates a new render buffer.
+func (gs *GLS) GenRenderbuffer() uint32 {
+
+	gs.renderbufferMap[gs.renderbufferMapIndex] = gs.gl.Call("createRenderbuffer")
+	gs.checkError("GenRenderbuffer")
+	idx := gs.renderbufferMapIndex
+	gs.renderbufferMapIndex++
+	gs.stats.Rbos++
+	return idx
+}
+
+// BindFramebuffer sets the current framebuffer, zero is the default framebuffer.
+func (gs *GLS) BindFramebuffer(fb uint32) {
+
+	gs.gl.Call("bindFramebuffer", FRAMEBUFFER, gs.framebuffer(fb))
+	gs.checkError("BindFramebuffer")
+}
+
+// BindRenderbuffer sets the current render buffer, zero unbinds it.
+func (gs *GLS) BindRenderbuffer(rb uint32) {
+
+	gs.gl.Call("bindRenderbuffer", RENDERBUFFER, gs.renderbuffer(rb))
+	gs.checkError("BindRenderbuffer")
+}
+
+// RenderbufferStorage allocates space for the bound render buffer.
+func (gs *GLS) RenderbufferStorage(format uint, width int, height int) {
+
+	gs.gl.Call("renderbufferStorage", RENDERBUFFER, format, width, height)
+	gs.checkError("RenderbufferStorage")
+}
+
+// FramebufferRenderbuffer attaches a renderbuffer object to the bound framebuffer object.
+func (gs *GLS) FramebufferRenderbuffer(attachment uint, rb uint32) {
+
+	gs.gl.Call("framebufferRenderbuffer", DRAW_FRAMEBUFFER, attachment, RENDERBUFFER, gs.renderbuffer(rb))
+	gs.checkError("FramebufferRenderbuffer")
+}
+
+// FramebufferTexture2D attaches a level of a texture object as a logical buffer to the currently bound framebuffer object
+func (gs *GLS) FramebufferTexture2D(attachment uint, textarget uint, tex uint32) {
+
+	gs.gl.Call("framebufferTexture2D", FRAMEBUFFER, attachment, textarget, gs.textureMap[tex], 0)
+	gs.checkError("FramebufferTexture2D")
+}
+
+// CheckFramebufferStatus get the framebuffer status
+func (gs *GLS) CheckFramebufferStatus() uint32 {
+
+	return uint32(gs.gl.Call("checkFramebufferStatus", FRAMEBUFFER).Int())
+}
+
+func (gs *GLS) framebuffer(fb uint32) js.Value {
+
+	if fb == 0 {
+		return js.Null()
+	}
+	return gs.framebufferMap[fb]
+}
+
+func (gs *GLS) renderbuffer(rb uint32) js.Value {
+
+	if rb == 0 {
+		return js.Null()
+	}
+	return gs.renderbufferMap[rb]
+}
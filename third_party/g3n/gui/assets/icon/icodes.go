@@ -0,0 +1,1886 @@
+// File generated by g3nicodes. Do not edit.
+// This file is based on the original 'codepoints' file
+// from the material design icon fonts:
+// https://github.com/google/material-design-icons
+
+package icon
+
+// Icon constants.
+const (
+	N3dRotation                           = string(0xe84d)
+	AcUnit                                = string(0xeb3b)
+	AccessAlarm                           = string(0xe190)
+	AccessAlarms                          = string(0xe191)
+	AccessTime                            = string(0xe192)
+	Accessibility                         = string(0xe84e)
+	Accessible                            = string(0xe914)
+	AccountBalance                        = string(0xe84f)
+	AccountBalanceWallet                  = string(0xe850)
+	AccountBox                            = string(0xe851)
+	AccountCircle                         = string(0xe853)
+	Adb                                   = string(0xe60e)
+	Add                                   = string(0xe145)
+	AddAPhoto                             = string(0xe439)
+	AddAlarm                              = string(0xe193)
+	AddAlert                              = string(0xe003)
+	AddBox                                = string(0xe146)
+	AddCircle                             = string(0xe147)
+	AddCircleOutline                      = string(0xe148)
+	AddLocation                           = string(0xe567)
+	AddShoppingCart                       = string(0xe854)
+	AddToPhotos                           = string(0xe39d)
+	AddToQueue                            = string(0xe05c)
+	Adjust                                = string(0xe39e)
+	AirlineSeatFlat                       = string(0xe630)
+	AirlineSeatFlatAngled                 = string(0xe631)
+	AirlineSeatIndividualSuite            = string(0xe632)
+	AirlineSeatLegroomExtra               = string(0xe633)
+	AirlineSeatLegroomNormal              = string(0xe634)
+	AirlineSeatLegroomReduced             = string(0xe635)
+	AirlineSeatReclineExtra               = string(0xe636)
+	AirlineSeatReclineNormal              = string(0xe637)
+	AirplanemodeActive                    = string(0xe195)
+	AirplanemodeInactive                  = string(0xe194)
+	Airplay                               = string(0xe055)
+	AirportShuttle                        = string(0xeb3c)
+	Alarm                                 = string(0xe855)
+	AlarmAdd                              = string(0xe856)
+	AlarmOff                              = string(0xe857)
+	AlarmOn                               = string(0xe858)
+	Album                                 = string(0xe019)
+	AllInclusive                          = string(0xeb3d)
+	AllOut                                = string(0xe90b)
+	Android                               = string(0xe859)
+	Announcement                          = string(0xe85a)
+	Apps                                  = string(0xe5c3)
+	Archive                               = string(0xe149)
+	ArrowBack                             = string(0xe5c4)
+	ArrowDownward                         = string(0xe5db)
+	ArrowDropDown                         = string(0xe5c5)
+	ArrowDropDownCircle                   = string(0xe5c6)
+	ArrowDropUp                           = string(0xe5c7)
+	ArrowForward                          = string(0xe5c8)
+	ArrowUpward                           = string(0xe5d8)
+	ArtTrack                              = string(0xe060)
+	AspectRatio                           = string(0xe85b)
+	Assessment                            = string(0xe85c)
+	Assignment                            = string(0xe85d)
+	AssignmentInd                         = string(0xe85e)
+	AssignmentLate                        = string(0xe85f)
+	AssignmentReturn                      = string(0xe860)
+	AssignmentReturned                    = string(0xe861)
+	AssignmentTurnedIn                    = string(0xe862)
+	Assistant                             = string(0xe39f)
+	AssistantPhoto                        = string(0xe3a0)
+	AttachFile                            = string(0xe226)
+	AttachMoney                           = string(0xe227)
+	Attachment                            = string(0xe2bc)
+	Audiotrack                            = string(0xe3a1)
+	Autorenew                             = string(0xe863)
+	AvTimer                               = string(0xe01b)
+	Backspace                             = string(0xe14a)
+	Backup                                = string(0xe864)
+	BatteryAlert                          = string(0xe19c)
+	BatteryChargingFull                   = string(0xe1a3)
+	BatteryFull                           = string(0xe1a4)
+	BatteryStd                            = string(0xe1a5)
+	BatteryUnknown                        = string(0xe1a6)
+	BeachAccess                           = string(0xeb3e)
+	Beenhere                              = string(0xe52d)
+	Block                                 = string(0xe14b)
+	Bluetooth                             = string(0xe1a7)
+	BluetoothAudio                        = string(0xe60f)
+	BluetoothConnected                    = string(0xe1a8)
+	BluetoothDisabled                     = string(0xe1a9)
+	BluetoothSearching                    = string(0xe1aa)
+	BlurCircular                          = string(0xe3a2)
+	BlurLinear                            = string(0xe3a3)
+	BlurOff                               = string(0xe3a4)
+	BlurOn                                = string(0xe3a5)
+	Book                                  = string(0xe865)
+	Bookmark                              = string(0xe866)
+	BookmarkBorder                        = string(0xe867)
+	BorderAll                             = string(0xe228)
+	BorderBottom                          = string(0xe229)
+	BorderClear                           = string(0xe22a)
+	BorderColor                           = string(0xe22b)
+	BorderHorizontal                      = string(0xe22c)
+	BorderInner                           = string(0xe22d)
+	BorderLeft                            = string(0xe22e)
+	BorderOuter                           = string(0xe22f)
+	BorderRight                           = string(0xe230)
+	BorderStyle                           = string(0xe231)
+	BorderTop                             = string(0xe232)
+	BorderVertical                        = string(0xe233)
+	BrandingWatermark                     = string(0xe06b)
+	Brightness1                           = string(0xe3a6)
+	Brightness2                           = string(0xe3a7)
+	Brightness3                           = string(0xe3a8)
+	Brightness4                           = string(0xe3a9)
+	Brightness5                           = string(0xe3aa)
+	Brightness6                           = string(0xe3ab)
+	Brightness7                           = string(0xe3ac)
+	BrightnessAuto                        = string(0xe1ab)
+	BrightnessHigh                        = string(0xe1ac)
+	BrightnessLow                         = string(0xe1ad)
+	BrightnessMedium                      = string(0xe1ae)
+	BrokenImage                           = string(0xe3ad)
+	Brush                                 = string(0xe3ae)
+	BubbleChart                           = string(0xe6dd)
+	BugReport                             = string(0xe868)
+	Build                                 = string(0xe869)
+	BurstMode                             = string(0xe43c)
+	Business                              = string(0xe0af)
+	BusinessCenter                        = string(0xeb3f)
+	Cached                                = string(0xe86a)
+	Cake                                  = string(0xe7e9)
+	Call                                  = string(0xe0b0)
+	CallEnd                               = string(0xe0b1)
+	CallMade                              = string(0xe0b2)
+	CallMerge                             = string(0xe0b3)
+	CallMissed                            = string(0xe0b4)
+	CallMissedOutgoing                    = string(0xe0e4)
+	CallReceived                          = string(0xe0b5)
+	CallSplit                             = string(0xe0b6)
+	CallToAction                          = string(0xe06c)
+	Camera                                = string(0xe3af)
+	CameraAlt                             = string(0xe3b0)
+	CameraEnhance                         = string(0xe8fc)
+	CameraFront                           = string(0xe3b1)
+	CameraRear                            = string(0xe3b2)
+	CameraRoll                            = string(0xe3b3)
+	Cancel                                = string(0xe5c9)
+	CardGiftcard                          = string(0xe8f6)
+	CardMembership                        = string(0xe8f7)
+	CardTravel                            = string(0xe8f8)
+	Casino                                = string(0xeb40)
+	Cast                                  = string(0xe307)
+	CastConnected                         = string(0xe308)
+	CenterFocusStrong                     = string(0xe3b4)
+	CenterFocusWeak                       = string(0xe3b5)
+	ChangeHistory                         = string(0xe86b)
+	Chat                                  = string(0xe0b7)
+	ChatBubble                            = string(0xe0ca)
+	ChatBubbleOutline                     = string(0xe0cb)
+	Check                                 = string(0xe5ca)
+	CheckBox                              = string(0xe834)
+	CheckBoxOutlineBlank                  = string(0xe835)
+	CheckCircle                           = string(0xe86c)
+	ChevronLeft                           = string(0xe5cb)
+	ChevronRight                          = string(0xe5cc)
+	ChildCare                             = string(0xeb41)
+	ChildFriendly                         = string(0xeb42)
+	ChromeReaderMode                      = string(0xe86d)
+	Class                                 = string(0xe86e)
+	Clear                                 = string(0xe14c)
+	ClearAll                              = string(0xe0b8)
+	Close                                 = string(0xe5cd)
+	ClosedCaption                         = string(0xe01c)
+	Cloud                                 = string(0xe2bd)
+	CloudCircle                           = string(0xe2be)
+	CloudDone                             = string(0xe2bf)
+	CloudDownload                         = string(0xe2c0)
+	CloudOff                              = string(0xe2c1)
+	CloudQueue                            = string(0xe2c2)
+	CloudUpload                           = string(0xe2c3)
+	Code                                  = string(0xe86f)
+	Collections                           = string(0xe3b6)
+	CollectionsBookmark                   = string(0xe431)
+	ColorLens                             = string(0xe3b7)
+	Colorize                              = string(0xe3b8)
+	Comment                               = string(0xe0b9)
+	Compare                               = string(0xe3b9)
+	CompareArrows                         = string(0xe915)
+	Computer                              = string(0xe30a)
+	ConfirmationNumber                    = string(0xe638)
+	ContactMail                           = string(0xe0d0)
+	ContactPhone                          = string(0xe0cf)
+	Contacts                              = string(0xe0ba)
+	ContentCopy                           = string(0xe14d)
+	ContentCut                            = string(0xe14e)
+	ContentPaste                          = string(0xe14f)
+	ControlPoint                          = string(0xe3ba)
+	ControlPointDuplicate                 = string(0xe3bb)
+	Copyright                             = string(0xe90c)
+	Create                                = string(0xe150)
+	CreateNewFolder                       = string(0xe2cc)
+	CreditCard                            = string(0xe870)
+	Crop                                  = string(0xe3be)
+	Crop169                               = string(0xe3bc)
+	Crop32                                = string(0xe3bd)
+	Crop54                                = string(0xe3bf)
+	Crop75                                = string(0xe3c0)
+	CropDin                               = string(0xe3c1)
+	CropFree                              = string(0xe3c2)
+	CropLandscape                         = string(0xe3c3)
+	CropOriginal                          = string(0xe3c4)
+	CropPortrait                          = string(0xe3c5)
+	CropRotate                            = string(0xe437)
+	CropSquare                            = string(0xe3c6)
+	Dashboard                             = string(0xe871)
+	DataUsage                             = string(0xe1af)
+	DateRange                             = string(0xe916)
+	Dehaze                                = string(0xe3c7)
+	Delete                                = string(0xe872)
+	DeleteForever                         = string(0xe92b)
+	DeleteSweep                           = string(0xe16c)
+	Description                           = string(0xe873)
+	DesktopMac                            = string(0xe30b)
+	DesktopWindows                        = string(0xe30c)
+	Details                               = string(0xe3c8)
+	DeveloperBoard                        = string(0xe30d)
+	DeveloperMode                         = string(0xe1b0)
+	DeviceHub                             = string(0xe335)
+	Devices                               = string(0xe1b1)
+	DevicesOther                          = string(0xe337)
+	DialerSip                             = string(0xe0bb)
+	Dialpad                               = string(0xe0bc)
+	Directions                            = string(0xe52e)
+	DirectionsBike                        = string(0xe52f)
+	DirectionsBoat                        = string(0xe532)
+	DirectionsBus                         = string(0xe530)
+	DirectionsCar                         = string(0xe531)
+	DirectionsRailway                     = string(0xe534)
+	DirectionsRun                         = string(0xe566)
+	DirectionsSubway                      = string(0xe533)
+	DirectionsTransit                     = string(0xe535)
+	DirectionsWalk                        = string(0xe536)
+	DiscFull                              = string(0xe610)
+	Dns                                   = string(0xe875)
+	DoNotDisturb                          = string(0xe612)
+	DoNotDisturbAlt                       = string(0xe611)
+	DoNotDisturbOff                       = string(0xe643)
+	DoNotDisturbOn                        = string(0xe644)
+	Dock                                  = string(0xe30e)
+	Domain                                = string(0xe7ee)
+	Done                                  = string(0xe876)
+	DoneAll                               = string(0xe877)
+	DonutLarge                            = string(0xe917)
+	DonutSmall                            = string(0xe918)
+	Drafts                                = string(0xe151)
+	DragHandle                            = string(0xe25d)
+	DriveEta                              = string(0xe613)
+	Dvr                                   = string(0xe1b2)
+	Edit                                  = string(0xe3c9)
+	EditLocation                          = string(0xe568)
+	Eject                                 = string(0xe8fb)
+	Email                                 = string(0xe0be)
+	EnhancedEncryption                    = string(0xe63f)
+	Equalizer                             = string(0xe01d)
+	Error                                 = string(0xe000)
+	ErrorOutline                          = string(0xe001)
+	EuroSymbol                            = string(0xe926)
+	EvStation                             = string(0xe56d)
+	Event                                 = string(0xe878)
+	EventAvailable                        = string(0xe614)
+	EventBusy                             = string(0xe615)
+	EventNote                             = string(0xe616)
+	EventSeat                             = string(0xe903)
+	ExitToApp                             = string(0xe879)
+	ExpandLess                            = string(0xe5ce)
+	ExpandMore                            = string(0xe5cf)
+	Explicit                              = string(0xe01e)
+	Explore                               = string(0xe87a)
+	Exposure                              = string(0xe3ca)
+	ExposureNeg1                          = string(0xe3cb)
+	ExposureNeg2                          = string(0xe3cc)
+	ExposurePlus1                         = string(0xe3cd)
+	ExposurePlus2                         = string(0xe3ce)
+	ExposureZero                          = string(0xe3cf)
+	Extension                             = string(0xe87b)
+	Face                                  = string(0xe87c)
+	FastForward                           = string(0xe01f)
+	FastRewind                            = string(0xe020)
+	Favorite                              = string(0xe87d)
+	FavoriteBorder                        = string(0xe87e)
+	FeaturedPlayList                      = string(0xe06d)
+	FeaturedVideo                         = string(0xe06e)
+	Feedback                              = string(0xe87f)
+	FiberDvr                              = string(0xe05d)
+	FiberManualRecord                     = string(0xe061)
+	FiberNew                              = string(0xe05e)
+	FiberPin                              = string(0xe06a)
+	FiberSmartRecord                      = string(0xe062)
+	FileDownload                          = string(0xe2c4)
+	FileUpload                            = string(0xe2c6)
+	Filter                                = string(0xe3d3)
+	Filter1                               = string(0xe3d0)
+	Filter2                               = string(0xe3d1)
+	Filter3                               = string(0xe3d2)
+	Filter4                               = string(0xe3d4)
+	Filter5                               = string(0xe3d5)
+	Filter6                               = string(0xe3d6)
+	Filter7                               = string(0xe3d7)
+	Filter8                               = string(0xe3d8)
+	Filter9                               = string(0xe3d9)
+	Filter9Plus                           = string(0xe3da)
+	FilterBAndW                           = string(0xe3db)
+	FilterCenterFocus                     = string(0xe3dc)
+	FilterDrama                           = string(0xe3dd)
+	FilterFrames                          = string(0xe3de)
+	FilterHdr                             = string(0xe3df)
+	FilterList                            = string(0xe152)
+	FilterNone                            = string(0xe3e0)
+	FilterTiltShift                       = string(0xe3e2)
+	FilterVintage                         = string(0xe3e3)
+	FindInPage                            = string(0xe880)
+	FindReplace                           = string(0xe881)
+	Fingerprint                           = string(0xe90d)
+	FirstPage                             = string(0xe5dc)
+	FitnessCenter                         = string(0xeb43)
+	Flag                                  = string(0xe153)
+	Flare                                 = string(0xe3e4)
+	FlashAuto                             = string(0xe3e5)
+	FlashOff                              = string(0xe3e6)
+	FlashOn                               = string(0xe3e7)
+	Flight                                = string(0xe539)
+	FlightLand                            = string(0xe904)
+	FlightTakeoff                         = string(0xe905)
+	Flip                                  = string(0xe3e8)
+	FlipToBack                            = string(0xe882)
+	FlipToFront                           = string(0xe883)
+	Folder                                = string(0xe2c7)
+	FolderOpen                            = string(0xe2c8)
+	FolderShared                          = string(0xe2c9)
+	FolderSpecial                         = string(0xe617)
+	FontDownload                          = string(0xe167)
+	FormatAlignCenter                     = string(0xe234)
+	FormatAlignJustify                    = string(0xe235)
+	FormatAlignLeft                       = string(0xe236)
+	FormatAlignRight                      = string(0xe237)
+	FormatBold                            = string(0xe238)
+	FormatClear                           = string(0xe239)
+	FormatColorFill                       = string(0xe23a)
+	FormatColorReset                      = string(0xe23b)
+	FormatColorText                       = string(0xe23c)
+	FormatIndentDecrease                  = string(0xe23d)
+	FormatIndentIncrease                  = string(0xe23e)
+	FormatItalic                          = string(0xe23f)
+	FormatLineSpacing                     = string(0xe240)
+	FormatListBulleted                    = string(0xe241)
+	FormatListNumbered                    = string(0xe242)
+	FormatPaint                           = string(0xe243)
+	FormatQuote                           = string(0xe244)
+	FormatShapes                          = string(0xe25e)
+	FormatSize                            = string(0xe245)
+	FormatStrikethrough                   = string(0xe246)
+	FormatTextdirectionLToR               = string(0xe247)
+	FormatTextdirectionRToL               = string(0xe248)
+	FormatUnderlined                      = string(0xe249)
+	Forum                                 = string(0xe0bf)
+	Forward                               = string(0xe154)
+	Forward10                             = string(0xe056)
+	Forward30                             = string(0xe057)
+	Forward5                              = string(0xe058)
+	FreeBreakfast                         = string(0xeb44)
+	Fullscreen                            = string(0xe5d0)
+	FullscreenExit                        = string(0xe5d1)
+	Functions                             = string(0xe24a)
+	GTranslate                            = string(0xe927)
+	Gamepad                               = string(0xe30f)
+	Games                                 = string(0xe021)
+	Gavel                                 = string(0xe90e)
+	Gesture                               = string(0xe155)
+	GetApp                                = string(0xe884)
+	Gif                                   = string(0xe908)
+	GolfCourse                            = string(0xeb45)
+	GpsFixed                              = string(0xe1b3)
+	GpsNotFixed                           = string(0xe1b4)
+	GpsOff                                = string(0xe1b5)
+	Grade                                 = string(0xe885)
+	Gradient                              = string(0xe3e9)
+	Grain                                 = string(0xe3ea)
+	GraphicEq                             = string(0xe1b8)
+	GridOff                               = string(0xe3eb)
+	GridOn                                = string(0xe3ec)
+	Group                                 = string(0xe7ef)
+	GroupAdd                              = string(0xe7f0)
+	GroupWork                             = string(0xe886)
+	Hd                                    = string(0xe052)
+	HdrOff                                = string(0xe3ed)
+	HdrOn                                 = string(0xe3ee)
+	HdrStrong                             = string(0xe3f1)
+	HdrWeak                               = string(0xe3f2)
+	Headset                               = string(0xe310)
+	HeadsetMic                            = string(0xe311)
+	Healing                               = string(0xe3f3)
+	Hearing                               = string(0xe023)
+	Help                                  = string(0xe887)
+	HelpOutline                           = string(0xe8fd)
+	HighQuality                           = string(0xe024)
+	Highlight                             = string(0xe25f)
+	HighlightOff                          = string(0xe888)
+	History                               = string(0xe889)
+	Home                                  = string(0xe88a)
+	HotTub                                = string(0xeb46)
+	Hotel                                 = string(0xe53a)
+	HourglassEmpty                        = string(0xe88b)
+	HourglassFull                         = string(0xe88c)
+	Http                                  = string(0xe902)
+	Https                                 = string(0xe88d)
+	Image                                 = string(0xe3f4)
+	ImageAspectRatio                      = string(0xe3f5)
+	ImportContacts                        = string(0xe0e0)
+	ImportExport                          = string(0xe0c3)
+	ImportantDevices                      = string(0xe912)
+	Inbox                                 = string(0xe156)
+	IndeterminateCheckBox                 = string(0xe909)
+	Info                                  = string(0xe88e)
+	InfoOutline                           = string(0xe88f)
+	Input                                 = string(0xe890)
+	InsertChart                           = string(0xe24b)
+	InsertComment                         = string(0xe24c)
+	InsertDriveFile                       = string(0xe24d)
+	InsertEmoticon                        = string(0xe24e)
+	InsertInvitation                      = string(0xe24f)
+	InsertLink                            = string(0xe250)
+	InsertPhoto                           = string(0xe251)
+	InvertColors                          = string(0xe891)
+	InvertColorsOff                       = string(0xe0c4)
+	Iso                                   = string(0xe3f6)
+	Keyboard                              = string(0xe312)
+	KeyboardArrowDown                     = string(0xe313)
+	KeyboardArrowLeft                     = string(0xe314)
+	KeyboardArrowRight                    = string(0xe315)
+	KeyboardArrowUp                       = string(0xe316)
+	KeyboardBackspace                     = string(0xe317)
+	KeyboardCapslock                      = string(0xe318)
+	KeyboardHide                          = string(0xe31a)
+	KeyboardReturn                        = string(0xe31b)
+	KeyboardTab                           = string(0xe31c)
+	KeyboardVoice                         = string(0xe31d)
+	Kitchen                               = string(0xeb47)
+	Label                                 = string(0xe892)
+	LabelOutline                          = string(0xe893)
+	Landscape                             = string(0xe3f7)
+	Language                              = string(0xe894)
+	Laptop                                = string(0xe31e)
+	LaptopChromebook                      = string(0xe31f)
+	LaptopMac                             = string(0xe320)
+	LaptopWindows                         = string(0xe321)
+	LastPage                              = string(0xe5dd)
+	Launch                                = string(0xe895)
+	Layers                                = string(0xe53b)
+	LayersClear                           = string(0xe53c)
+	LeakAdd                               = string(0xe3f8)
+	LeakRemove                            = string(0xe3f9)
+	Lens                                  = string(0xe3fa)
+	LibraryAdd                            = string(0xe02e)
+	LibraryBooks                          = string(0xe02f)
+	LibraryMusic                          = string(0xe030)
+	LightbulbOutline                      = string(0xe90f)
+	LineStyle                             = string(0xe919)
+	LineWeight                            = string(0xe91a)
+	LinearScale                           = string(0xe260)
+	Link                                  = string(0xe157)
+	LinkedCamera                          = string(0xe438)
+	List                                  = string(0xe896)
+	LiveHelp                              = string(0xe0c6)
+	LiveTv                                = string(0xe639)
+	LocalActivity                         = string(0xe53f)
+	LocalAirport                          = string(0xe53d)
+	LocalAtm                              = string(0xe53e)
+	LocalBar                              = string(0xe540)
+	LocalCafe                             = string(0xe541)
+	LocalCarWash                          = string(0xe542)
+	LocalConvenienceStore                 = string(0xe543)
+	LocalDining                           = string(0xe556)
+	LocalDrink                            = string(0xe544)
+	LocalFlorist                          = string(0xe545)
+	LocalGasStation                       = string(0xe546)
+	LocalGroceryStore                     = string(0xe547)
+	LocalHospital                         = string(0xe548)
+	LocalHotel                            = string(0xe549)
+	LocalLaundryService                   = string(0xe54a)
+	LocalLibrary                          = string(0xe54b)
+	LocalMall                             = string(0xe54c)
+	LocalMovies                           = string(0xe54d)
+	LocalOffer                            = string(0xe54e)
+	LocalParking                          = string(0xe54f)
+	LocalPharmacy                         = string(0xe550)
+	LocalPhone                            = string(0xe551)
+	LocalPizza                            = string(0xe552)
+	LocalPlay                             = string(0xe553)
+	LocalPostOffice                       = string(0xe554)
+	LocalPrintshop                        = string(0xe555)
+	LocalSee                              = string(0xe557)
+	LocalShipping                         = string(0xe558)
+	LocalTaxi                             = string(0xe559)
+	LocationCity                          = string(0xe7f1)
+	LocationDisabled                      = string(0xe1b6)
+	LocationOff                           = string(0xe0c7)
+	LocationOn                            = string(0xe0c8)
+	LocationSearching                     = string(0xe1b7)
+	Lock                                  = string(0xe897)
+	LockOpen                              = string(0xe898)
+	LockOutline                           = string(0xe899)
+	Looks                                 = string(0xe3fc)
+	Looks3                                = string(0xe3fb)
+	Looks4                                = string(0xe3fd)
+	Looks5                                = string(0xe3fe)
+	Looks6                                = string(0xe3ff)
+	LooksOne                              = string(0xe400)
+	LooksTwo                              = string(0xe401)
+	Loop                                  = string(0xe028)
+	Loupe                                 = string(0xe402)
+	LowPriority                           = string(0xe16d)
+	Loyalty                               = string(0xe89a)
+	Mail                                  = string(0xe158)
+	MailOutline                           = string(0xe0e1)
+	Map                                   = string(0xe55b)
+	Markunread                            = string(0xe159)
+	MarkunreadMailbox                     = string(0xe89b)
+	Memory                                = string(0xe322)
+	Menu                                  = string(0xe5d2)
+	MergeType                             = string(0xe252)
+	Message                               = string(0xe0c9)
+	Mic                                   = string(0xe029)
+	MicNone                               = string(0xe02a)
+	MicOff                                = string(0xe02b)
+	Mms                                   = string(0xe618)
+	ModeComment                           = string(0xe253)
+	ModeEdit                              = string(0xe254)
+	MonetizationOn                        = string(0xe263)
+	MoneyOff                              = string(0xe25c)
+	MonochromePhotos                      = string(0xe403)
+	Mood                                  = string(0xe7f2)
+	MoodBad                               = string(0xe7f3)
+	More                                  = string(0xe619)
+	MoreHoriz                             = string(0xe5d3)
+	MoreVert                              = string(0xe5d4)
+	Motorcycle                            = string(0xe91b)
+	Mouse                                 = string(0xe323)
+	MoveToInbox                           = string(0xe168)
+	Movie                                 = string(0xe02c)
+	MovieCreation                         = string(0xe404)
+	MovieFilter                           = string(0xe43a)
+	MultilineChart                        = string(0xe6df)
+	MusicNote                             = string(0xe405)
+	MusicVideo                            = string(0xe063)
+	MyLocation                            = string(0xe55c)
+	Nature                                = string(0xe406)
+	NaturePeople                          = string(0xe407)
+	NavigateBefore                        = string(0xe408)
+	NavigateNext                          = string(0xe409)
+	Navigation                            = string(0xe55d)
+	NearMe                                = string(0xe569)
+	NetworkCell                           = string(0xe1b9)
+	NetworkCheck                          = string(0xe640)
+	NetworkLocked                         = string(0xe61a)
+	NetworkWifi                           = string(0xe1ba)
+	NewReleases                           = string(0xe031)
+	NextWeek                              = string(0xe16a)
+	Nfc                                   = string(0xe1bb)
+	NoEncryption                          = string(0xe641)
+	NoSim                                 = string(0xe0cc)
+	NotInterested                         = string(0xe033)
+	Note                                  = string(0xe06f)
+	NoteAdd                               = string(0xe89c)
+	Notifications                         = string(0xe7f4)
+	NotificationsActive                   = string(0xe7f7)
+	NotificationsNone                     = string(0xe7f5)
+	NotificationsOff                      = string(0xe7f6)
+	NotificationsPaused                   = string(0xe7f8)
+	OfflinePin                            = string(0xe90a)
+	OndemandVideo                         = string(0xe63a)
+	Opacity                               = string(0xe91c)
+	OpenInBrowser                         = string(0xe89d)
+	OpenInNew                             = string(0xe89e)
+	OpenWith                              = string(0xe89f)
+	Pages                                 = string(0xe7f9)
+	Pageview                              = string(0xe8a0)
+	Palette                               = string(0xe40a)
+	PanTool                               = string(0xe925)
+	Panorama                              = string(0xe40b)
+	PanoramaFishEye                       = string(0xe40c)
+	PanoramaHorizontal                    = string(0xe40d)
+	PanoramaVertical                      = string(0xe40e)
+	PanoramaWideAngle                     = string(0xe40f)
+	PartyMode                             = string(0xe7fa)
+	Pause                                 = string(0xe034)
+	PauseCircleFilled                     = string(0xe035)
+	PauseCircleOutline                    = string(0xe036)
+	Payment                               = string(0xe8a1)
+	People                                = string(0xe7fb)
+	PeopleOutline                         = string(0xe7fc)
+	PermCameraMic                         = string(0xe8a2)
+	PermContactCalendar                   = string(0xe8a3)
+	PermDataSetting                       = string(0xe8a4)
+	PermDeviceInformation                 = string(0xe8a5)
+	PermIdentity                          = string(0xe8a6)
+	PermMedia                             = string(0xe8a7)
+	PermPhoneMsg                          = string(0xe8a8)
+	PermScanWifi                          = string(0xe8a9)
+	Person                                = string(0xe7fd)
+	PersonAdd                             = string(0xe7fe)
+	PersonOutline                         = string(0xe7ff)
+	PersonPin                             = string(0xe55a)
+	PersonPinCircle                       = string(0xe56a)
+	PersonalVideo                         = string(0xe63b)
+	Pets                                  = string(0xe91d)
+	Phone                                 = string(0xe0cd)
+	PhoneAndroid                          = string(0xe324)
+	PhoneBluetoothSpeaker                 = string(0xe61b)
+	PhoneForwarded                        = string(0xe61c)
+	PhoneInTalk                           = string(0xe61d)
+	PhoneIphone                           = string(0xe325)
+	PhoneLocked                           = string(0xe61e)
+	PhoneMissed                           = string(0xe61f)
+	PhonePaused                           = string(0xe620)
+	Phonelink                             = string(0xe326)
+	PhonelinkErase                        = string(0xe0db)
+	PhonelinkLock                         = string(0xe0dc)
+	PhonelinkOff                          = string(0xe327)
+	PhonelinkRing                         = string(0xe0dd)
+	PhonelinkSetup                        = string(0xe0de)
+	Photo                                 = string(0xe410)
+	PhotoAlbum                            = string(0xe411)
+	PhotoCamera                           = string(0xe412)
+	PhotoFilter                           = string(0xe43b)
+	PhotoLibrary                          = string(0xe413)
+	PhotoSizeSelectActual                 = string(0xe432)
+	PhotoSizeSelectLarge                  = string(0xe433)
+	PhotoSizeSelectSmall                  = string(0xe434)
+	PictureAsPdf                          = string(0xe415)
+	PictureInPicture                      = string(0xe8aa)
+	PictureInPictureAlt                   = string(0xe911)
+	PieChart                              = string(0xe6c4)
+	PieChartOutlined                      = string(0xe6c5)
+	PinDrop                               = string(0xe55e)
+	Place                                 = string(0xe55f)
+	PlayArrow                             = string(0xe037)
+	PlayCircleFilled                      = string(0xe038)
+	PlayCircleOutline                     = string(0xe039)
+	PlayForWork                           = string(0xe906)
+	PlaylistAdd                           = string(0xe03b)
+	PlaylistAddCheck                      = string(0xe065)
+	PlaylistPlay                          = string(0xe05f)
+	PlusOne                               = string(0xe800)
+	Poll                                  = string(0xe801)
+	Polymer                               = string(0xe8ab)
+	Pool                                  = string(0xeb48)
+	PortableWifiOff                       = string(0xe0ce)
+	Portrait                              = string(0xe416)
+	Power                                 = string(0xe63c)
+	PowerInput                            = string(0xe336)
+	PowerSettingsNew                      = string(0xe8ac)
+	PregnantWoman                         = string(0xe91e)
+	PresentToAll                          = string(0xe0df)
+	Print                                 = string(0xe8ad)
+	PriorityHigh                          = string(0xe645)
+	Public                                = string(0xe80b)
+	Publish                               = string(0xe255)
+	QueryBuilder                          = string(0xe8ae)
+	QuestionAnswer                        = string(0xe8af)
+	Queue                                 = string(0xe03c)
+	QueueMusic                            = string(0xe03d)
+	QueuePlayNext                         = string(0xe066)
+	Radio                                 = string(0xe03e)
+	RadioButtonChecked                    = string(0xe837)
+	RadioButtonUnchecked                  = string(0xe836)
+	RateReview                            = string(0xe560)
+	Receipt                               = string(0xe8b0)
+	RecentActors                          = string(0xe03f)
+	RecordVoiceOver                       = string(0xe91f)
+	Redeem                                = string(0xe8b1)
+	Redo                                  = string(0xe15a)
+	Refresh                               = string(0xe5d5)
+	Remove                                = string(0xe15b)
+	RemoveCircle                          = string(0xe15c)
+	RemoveCircleOutline                   = string(0xe15d)
+	RemoveFromQueue                       = string(0xe067)
+	RemoveRedEye                          = string(0xe417)
+	RemoveShoppingCart                    = string(0xe928)
+	Reorder                               = string(0xe8fe)
+	Repeat                                = string(0xe040)
+	RepeatOne                             = string(0xe041)
+	Replay                                = string(0xe042)
+	Replay10                              = string(0xe059)
+	Replay30                              = string(0xe05a)
+	Replay5                               = string(0xe05b)
+	Reply                                 = string(0xe15e)
+	ReplyAll                              = string(0xe15f)
+	Report                                = string(0xe160)
+	ReportProblem                         = string(0xe8b2)
+	Restaurant                            = string(0xe56c)
+	RestaurantMenu                        = string(0xe561)
+	Restore                               = string(0xe8b3)
+	RestorePage                           = string(0xe929)
+	RingVolume                            = string(0xe0d1)
+	Room                                  = string(0xe8b4)
+	RoomService                           = string(0xeb49)
+	Rotate90DegreesCcw                    = string(0xe418)
+	RotateLeft                            = string(0xe419)
+	RotateRight                           = string(0xe41a)
+	RoundedCorner                         = string(0xe920)
+	Router                                = string(0xe328)
+	Rowing                                = string(0xe921)
+	RssFeed                               = string(0xe0e5)
+	RvHookup                              = string(0xe642)
+	Satellite                             = string(0xe562)
+	Save                                  = string(0xe161)
+	Scanner                               = string(0xe329)
+	Schedule                              = string(0xe8b5)
+	School                                = string(0xe80c)
+	ScreenLockLandscape                   = string(0xe1be)
+	ScreenLockPortrait                    = string(0xe1bf)
+	ScreenLockRotation                    = string(0xe1c0)
+	ScreenRotation                        = string(0xe1c1)
+	ScreenShare                           = string(0xe0e2)
+	SdCard                                = string(0xe623)
+	SdStorage                             = string(0xe1c2)
+	Search                                = string(0xe8b6)
+	Security                              = string(0xe32a)
+	SelectAll                             = string(0xe162)
+	Send                                  = string(0xe163)
+	SentimentDissatisfied                 = string(0xe811)
+	SentimentNeutral                      = string(0xe812)
+	SentimentSatisfied                    = string(0xe813)
+	SentimentVeryDissatisfied             = string(0xe814)
+	SentimentVerySatisfied                = string(0xe815)
+	Settings                              = string(0xe8b8)
+	SettingsApplications                  = string(0xe8b9)
+	SettingsBackupRestore                 = string(0xe8ba)
+	SettingsBluetooth                     = string(0xe8bb)
+	SettingsBrightness                    = string(0xe8bd)
+	SettingsCell                          = string(0xe8bc)
+	SettingsEthernet                      = string(0xe8be)
+	SettingsInputAntenna                  = string(0xe8bf)
+	SettingsInputComponent                = string(0xe8c0)
+	SettingsInputComposite                = string(0xe8c1)
+	SettingsInputHdmi                     = string(0xe8c2)
+	SettingsInputSvideo                   = string(0xe8c3)
+	SettingsOverscan                      = string(0xe8c4)
+	SettingsPhone                         = string(0xe8c5)
+	SettingsPower                         = string(0xe8c6)
+	SettingsRemote                        = string(0xe8c7)
+	SettingsSystemDaydream                = string(0xe1c3)
+	SettingsVoice                         = string(0xe8c8)
+	Share                                 = string(0xe80d)
+	Shop                                  = string(0xe8c9)
+	ShopTwo                               = string(0xe8ca)
+	ShoppingBasket                        = string(0xe8cb)
+	ShoppingCart                          = string(0xe8cc)
+	ShortText                             = string(0xe261)
+	ShowChart                             = string(0xe6e1)
+	Shuffle                               = string(0xe043)
+	SignalCellular4Bar                    = string(0xe1c8)
+	SignalCellularConnectedNoInternet4Bar = string(0xe1cd)
+	SignalCellularNoSim                   = string(0xe1ce)
+	SignalCellularNull                    = string(0xe1cf)
+	SignalCellularOff                     = string(0xe1d0)
+	SignalWifi4Bar                        = string(0xe1d8)
+	SignalWifi4BarLock                    = string(0xe1d9)
+	SignalWifiOff                         = string(0xe1da)
+	SimCard                               = string(0xe32b)
+	SimCardAlert                          = string(0xe624)
+	SkipNext                              = string(0xe044)
+	SkipPrevious                          = string(0xe045)
+	Slideshow                             = string(0xe41b)
+	SlowMotionVideo                       = string(0xe068)
+	Smartphone                            = string(0xe32c)
+	SmokeFree                             = string(0xeb4a)
+	SmokingRooms                          = string(0xeb4b)
+	Sms                                   = string(0xe625)
+	SmsFailed                             = string(0xe626)
+	Snooze                                = string(0xe046)
+	Sort                                  = string(0xe164)
+	SortByAlpha                           = string(0xe053)
+	Spa                                   = string(0xeb4c)
+	SpaceBar                              = string(0xe256)
+	Speaker                               = string(0xe32d)
+	SpeakerGroup                          = string(0xe32e)
+	SpeakerNotes                          = string(0xe8cd)
+	SpeakerNotesOff                       = string(0xe92a)
+	SpeakerPhone                          = string(0xe0d2)
+	Spellcheck                            = string(0xe8ce)
+	Star                                  = string(0xe838)
+	StarBorder                            = string(0xe83a)
+	StarHalf                              = string(0xe839)
+	Stars                                 = string(0xe8d0)
+	StayCurrentLandscape                  = string(0xe0d3)
+	StayCurrentPortrait                   = string(0xe0d4)
+	StayPrimaryLandscape                  = string(0xe0d5)
+	StayPrimaryPortrait                   = string(0xe0d6)
+	Stop                                  = string(0xe047)
+	StopScreenShare                       = string(0xe0e3)
+	Storage                               = string(0xe1db)
+	Store                                 = string(0xe8d1)
+	StoreMallDirectory                    = string(0xe563)
+	Straighten                            = string(0xe41c)
+	Streetview                            = string(0xe56e)
+	StrikethroughS                        = string(0xe257)
+	Style                                 = string(0xe41d)
+	SubdirectoryArrowLeft                 = string(0xe5d9)
+	SubdirectoryArrowRight                = string(0xe5da)
+	Subject                               = string(0xe8d2)
+	Subscriptions                         = string(0xe064)
+	Subtitles                             = string(0xe048)
+	Subway                                = string(0xe56f)
+	SupervisorAccount                     = string(0xe8d3)
+	SurroundSound                         = string(0xe049)
+	SwapCalls                             = string(0xe0d7)
+	SwapHoriz                             = string(0xe8d4)
+	SwapVert                              = string(0xe8d5)
+	SwapVerticalCircle                    = string(0xe8d6)
+	SwitchCamera                          = string(0xe41e)
+	SwitchVideo                           = string(0xe41f)
+	Sync                                  = string(0xe627)
+	SyncDisabled                          = string(0xe628)
+	SyncProblem                           = string(0xe629)
+	SystemUpdate                          = string(0xe62a)
+	SystemUpdateAlt                       = string(0xe8d7)
+	Tab                                   = string(0xe8d8)
+	TabUnselected                         = string(0xe8d9)
+	Tablet                                = string(0xe32f)
+	TabletAndroid                         = string(0xe330)
+	TabletMac                             = string(0xe331)
+	TagFaces                              = string(0xe420)
+	TapAndPlay                            = string(0xe62b)
+	Terrain                               = string(0xe564)
+	TextFields                            = string(0xe262)
+	TextFormat                            = string(0xe165)
+	Textsms                               = string(0xe0d8)
+	Texture                               = string(0xe421)
+	Theaters                              = string(0xe8da)
+	ThumbDown                             = string(0xe8db)
+	ThumbUp                               = string(0xe8dc)
+	ThumbsUpDown                          = string(0xe8dd)
+	TimeToLeave                           = string(0xe62c)
+	Timelapse                             = string(0xe422)
+	Timeline                              = string(0xe922)
+	Timer                                 = string(0xe425)
+	Timer10                               = string(0xe423)
+	Timer3                                = string(0xe424)
+	TimerOff                              = string(0xe426)
+	Title                                 = string(0xe264)
+	Toc                                   = string(0xe8de)
+	Today                                 = string(0xe8df)
+	Toll                                  = string(0xe8e0)
+	Tonality                              = string(0xe427)
+	TouchApp                              = string(0xe913)
+	Toys                                  = string(0xe332)
+	TrackChanges                          = string(0xe8e1)
+	Traffic                               = string(0xe565)
+	Train                                 = string(0xe570)
+	Tram                                  = string(0xe571)
+	TransferWithinAStation                = string(0xe572)
+	Transform                             = string(0xe428)
+	Translate                             = string(0xe8e2)
+	TrendingDown                          = string(0xe8e3)
+	TrendingFlat                          = string(0xe8e4)
+	TrendingUp                            = string(0xe8e5)
+	Tune                                  = string(0xe429)
+	TurnedIn                              = string(0xe8e6)
+	TurnedInNot                           = string(0xe8e7)
+	Tv                                    = string(0xe333)
+	Unarchive                             = string(0xe169)
+	Undo                                  = string(0xe166)
+	UnfoldLess                            = string(0xe5d6)
+	UnfoldMore                            = string(0xe5d7)
+	Update                                = string(0xe923)
+	Usb                                   = string(0xe1e0)
+	VerifiedUser                          = string(0xe8e8)
+	VerticalAlignBottom                   = string(0xe258)
+	VerticalAlignCenter                   = string(0xe259)
+	VerticalAlignTop                      = string(0xe25a)
+	Vibration                             = string(0xe62d)
+	VideoCall                             = string(0xe070)
+	VideoLabel                            = string(0xe071)
+	VideoLibrary                          = string(0xe04a)
+	Videocam                              = string(0xe04b)
+	VideocamOff                           = string(0xe04c)
+	VideogameAsset                        = string(0xe338)
+	ViewAgenda                            = string(0xe8e9)
+	ViewArray                             = string(0xe8ea)
+	ViewCarousel                          = string(0xe8eb)
+	ViewColumn                            = string(0xe8ec)
+	ViewComfy                             = string(0xe42a)
+	ViewCompact                           = string(0xe42b)
+	ViewDay                               = string(0xe8ed)
+	ViewHeadline                          = string(0xe8ee)
+	ViewList                              = string(0xe8ef)
+	ViewModule                            = string(0xe8f0)
+	ViewQuilt                             = string(0xe8f1)
+	ViewStream                            = string(0xe8f2)
+	ViewWeek                              = string(0xe8f3)
+	Vignette                              = string(0xe435)
+	Visibility                            = string(0xe8f4)
+	VisibilityOff                         = string(0xe8f5)
+	VoiceChat                             = string(0xe62e)
+	Voicemail                             = string(0xe0d9)
+	VolumeDown                            = string(0xe04d)
+	VolumeMute                            = string(0xe04e)
+	VolumeOff                             = string(0xe04f)
+	VolumeUp                              = string(0xe050)
+	VpnKey                                = string(0xe0da)
+	VpnLock                               = string(0xe62f)
+	Wallpaper                             = string(0xe1bc)
+	Warning                               = string(0xe002)
+	Watch                                 = string(0xe334)
+	WatchLater                            = string(0xe924)
+	WbAuto                                = string(0xe42c)
+	WbCloudy                              = string(0xe42d)
+	WbIncandescent                        = string(0xe42e)
+	WbIridescent                          = string(0xe436)
+	WbSunny                               = string(0xe430)
+	Wc                                    = string(0xe63d)
+	Web                                   = string(0xe051)
+	WebAsset                              = string(0xe069)
+	Weekend                               = string(0xe16b)
+	Whatshot                              = string(0xe80e)
+	Widgets                               = string(0xe1bd)
+	Wifi                                  = string(0xe63e)
+	WifiLock                              = string(0xe1e1)
+	WifiTethering                         = string(0xe1e2)
+	Work                                  = string(0xe8f9)
+	WrapText                              = string(0xe25b)
+	YoutubeSearchedFor                    = string(0xe8fa)
+	ZoomIn                                = string(0xe8ff)
+	ZoomOut                               = string(0xe900)
+	ZoomOutMap                            = string(0xe56b)
+)
+
+// Codepoint returns the codepoint for the specified icon name.
+// Returns 0 if the name not found
+func Codepoint(name string) string {
+
+	return name2Codepoint[name]
+}
+
+// Maps icon name to codepoint
+var name2Codepoint = map[string]string{
+
+	"N3dRotation":                           string(0xe84d),
+	"AcUnit":                                string(0xeb3b),
+	"AccessAlarm":                           string(0xe190),
+	"AccessAlarms":                          string(0xe191),
+	"AccessTime":                            string(0xe192),
+	"Accessibility":                         string(0xe84e),
+	"Accessible":                            string(0xe914),
+	"AccountBalance":                        string(0xe84f),
+	"AccountBalanceWallet":                  string(0xe850),
+	"AccountBox":                            string(0xe851),
+	"AccountCircle":                         string(0xe853),
+	"Adb":                                   string(0xe60e),
+	"Add":                                   string(0xe145),
+	"AddAPhoto":                             string(0xe439),
+	"AddAlarm":                              string(0xe193),
+	"AddAlert":                              string(0xe003),
+	"AddBox":                                string(0xe146),
+	"AddCircle":                             string(0xe147),
+	"AddCircleOutline":                      string(0xe148),
+	"AddLocation":                           string(0xe567),
+	"AddShoppingCart":                       string(0xe854),
+	"AddToPhotos":                           string(0xe39d),
+	"AddToQueue":                            string(0xe05c),
+	"Adjust":                                string(0xe39e),
+	"AirlineSeatFlat":                       string(0xe630),
+	"AirlineSeatFlatAngled":                 string(0xe631),
+	"AirlineSeatIndividualSuite":            string(0xe632),
+	"AirlineSeatLegroomExtra":               string(0xe633),
+	"AirlineSeatLegroomNormal":              string(0xe634),
+	"AirlineSeatLegroomReduced":             string(0xe635),
+	"AirlineSeatReclineExtra":               string(0xe636),
+	"AirlineSeatReclineNormal":              string(0xe637),
+	"AirplanemodeActive":                    string(0xe195),
+	"AirplanemodeInactive":                  string(0xe194),
+	"Airplay":                               string(0xe055),
+	"AirportShuttle":                        string(0xeb3c),
+	"Alarm":                                 string(0xe855),
+	"AlarmAdd":                              string(0xe856),
+	"AlarmOff":                              string(0xe857),
+	"AlarmOn":                               string(0xe858),
+	"Album":                                 string(0xe019),
+	"AllInclusive":                          string(0xeb3d),
+	"AllOut":                                string(0xe90b),
+	"Android":                               string(0xe859),
+	"Announcement":                          string(0xe85a),
+	"Apps":                                  string(0xe5c3),
+	"Archive":                               string(0xe149),
+	"ArrowBack":                             string(0xe5c4),
+	"ArrowDownward":                         string(0xe5db),
+	"ArrowDropDown":                         string(0xe5c5),
+	"ArrowDropDownCircle":                   string(0xe5c6),
+	"ArrowDropUp":                           string(0xe5c7),
+	"ArrowForward":                          string(0xe5c8),
+	"ArrowUpward":                           string(0xe5d8),
+	"ArtTrack":                              string(0xe060),
+	"AspectRatio":                           string(0xe85b),
+	"Assessment":                            string(0xe85c),
+	"Assignment":                            string(0xe85d),
+	"AssignmentInd":                         string(0xe85e),
+	"AssignmentLate":                        string(0xe85f),
+	"AssignmentReturn":                      string(0xe860),
+	"AssignmentReturned":                    string(0xe861),
+	"AssignmentTurnedIn":                    string(0xe862),
+	"Assistant":                             string(0xe39f),
+	"AssistantPhoto":                        string(0xe3a0),
+	"AttachFile":                            string(0xe226),
+	"AttachMoney":                           string(0xe227),
+	"Attachment":                            string(0xe2bc),
+	"Audiotrack":                            string(0xe3a1),
+	"Autorenew":                             string(0xe863),
+	"AvTimer":                               string(0xe01b),
+	"Backspace":                             string(0xe14a),
+	"Backup":                                string(0xe864),
+	"BatteryAlert":                          string(0xe19c),
+	"BatteryChargingFull":                   string(0xe1a3),
+	"BatteryFull":                           string(0xe1a4),
+	"BatteryStd":                            string(0xe1a5),
+	"BatteryUnknown":                        string(0xe1a6),
+	"BeachAccess":                           string(0xeb3e),
+	"Beenhere":                              string(0xe52d),
+	"Block":                                 string(0xe14b),
+	"Bluetooth":                             string(0xe1a7),
+	"BluetoothAudio":                        string(0xe60f),
+	"BluetoothConnected":                    string(0xe1a8),
+	"BluetoothDisabled":                     string(0xe1a9),
+	"BluetoothSearching":                    string(0xe1aa),
+	"BlurCircular":                          string(0xe3a2),
+	"BlurLinear":                            string(0xe3a3),
+	"BlurOff":                               string(0xe3a4),
+	"BlurOn":                                string(0xe3a5),
+	"Book":                                  string(0xe865),
+	"Bookmark":                              string(0xe866),
+	"BookmarkBorder":                        string(0xe867),
+	"BorderAll":                             string(0xe228),
+	"BorderBottom":                          string(0xe229),
+	"BorderClear":                           string(0xe22a),
+	"BorderColor":                           string(0xe22b),
+	"BorderHorizontal":                      string(0xe22c),
+	"BorderInner":                           string(0xe22d),
+	"BorderLeft":                            string(0xe22e),
+	"BorderOuter":                           string(0xe22f),
+	"BorderRight":                           string(0xe230),
+	"BorderStyle":                           string(0xe231),
+	"BorderTop":                             string(0xe232),
+	"BorderVertical":                        string(0xe233),
+	"BrandingWatermark":                     string(0xe06b),
+	"Brightness1":                           string(0xe3a6),
+	"Brightness2":                           string(0xe3a7),
+	"Brightness3":                           string(0xe3a8),
+	"Brightness4":                           string(0xe3a9),
+	"Brightness5":                           string(0xe3aa),
+	"Brightness6":                           string(0xe3ab),
+	"Brightness7":                           string(0xe3ac),
+	"BrightnessAuto":                        string(0xe1ab),
+	"BrightnessHigh":                        string(0xe1ac),
+	"BrightnessLow":                         string(0xe1ad),
+	"BrightnessMedium":                      string(0xe1ae),
+	"BrokenImage":                           string(0xe3ad),
+	"Brush":                                 string(0xe3ae),
+	"BubbleChart":                           string(0xe6dd),
+	"BugReport":                             string(0xe868),
+	"Build":                                 string(0xe869),
+	"BurstMode":                             string(0xe43c),
+	"Business":                              string(0xe0af),
+	"BusinessCenter":                        string(0xeb3f),
+	"Cached":                                string(0xe86a),
+	"Cake":                                  string(0xe7e9),
+	"Call":                                  string(0xe0b0),
+	"CallEnd":                               string(0xe0b1),
+	"CallMade":                              string(0xe0b2),
+	"CallMerge":                             string(0xe0b3),
+	"CallMissed":                            string(0xe0b4),
+	"CallMissedOutgoing":                    string(0xe0e4),
+	"CallReceived":                          string(0xe0b5),
+	"CallSplit":                             string(0xe0b6),
+	"CallToAction":                          string(0xe06c),
+	"Camera":                                string(0xe3af),
+	"CameraAlt":                             string(0xe3b0),
+	"CameraEnhance":                         string(0xe8fc),
+	"CameraFront":                           string(0xe3b1),
+	"CameraRear":                            string(0xe3b2),
+	"CameraRoll":                            string(0xe3b3),
+	"Cancel":                                string(0xe5c9),
+	"CardGiftcard":                          string(0xe8f6),
+	"CardMembership":                        string(0xe8f7),
+	"CardTravel":                            string(0xe8f8),
+	"Casino":                                string(0xeb40),
+	"Cast":                                  string(0xe307),
+	"CastConnected":                         string(0xe308),
+	"CenterFocusStrong":                     string(0xe3b4),
+	"CenterFocusWeak":                       string(0xe3b5),
+	"ChangeHistory":                         string(0xe86b),
+	"Chat":                                  string(0xe0b7),
+	"ChatBubble":                            string(0xe0ca),
+	"ChatBubbleOutline":                     string(0xe0cb),
+	"Check":                                 string(0xe5ca),
+	"CheckBox":                              string(0xe834),
+	"CheckBoxOutlineBlank":                  string(0xe835),
+	"CheckCircle":                           string(0xe86c),
+	"ChevronLeft":                           string(0xe5cb),
+	"ChevronRight":                          string(0xe5cc),
+	"ChildCare":                             string(0xeb41),
+	"ChildFriendly":                         string(0xeb42),
+	"ChromeReaderMode":                      string(0xe86d),
+	"Class":                                 string(0xe86e),
+	"Clear":                                 string(0xe14c),
+	"ClearAll":                              string(0xe0b8),
+	"Close":                                 string(0xe5cd),
+	"ClosedCaption":                         string(0xe01c),
+	"Cloud":                                 string(0xe2bd),
+	"CloudCircle":                           string(0xe2be),
+	"CloudDone":                             string(0xe2bf),
+	"CloudDownload":                         string(0xe2c0),
+	"CloudOff":                              string(0xe2c1),
+	"CloudQueue":                            string(0xe2c2),
+	"CloudUpload":                           string(0xe2c3),
+	"Code":                                  string(0xe86f),
+	"Collections":                           string(0xe3b6),
+	"CollectionsBookmark":                   string(0xe431),
+	"ColorLens":                             string(0xe3b7),
+	"Colorize":                              string(0xe3b8),
+	"Comment":                               string(0xe0b9),
+	"Compare":                               string(0xe3b9),
+	"CompareArrows":                         string(0xe915),
+	"Computer":                              string(0xe30a),
+	"ConfirmationNumber":                    string(0xe638),
+	"ContactMail":                           string(0xe0d0),
+	"ContactPhone":                          string(0xe0cf),
+	"Contacts":                              string(0xe0ba),
+	"ContentCopy":                           string(0xe14d),
+	"ContentCut":                            string(0xe14e),
+	"ContentPaste":                          string(0xe14f),
+	"ControlPoint":                          string(0xe3ba),
+	"ControlPointDuplicate":                 string(0xe3bb),
+	"Copyright":                             string(0xe90c),
+	"Create":                                string(0xe150),
+	"CreateNewFolder":                       string(0xe2cc),
+	"CreditCard":                            string(0xe870),
+	"Crop":                                  string(0xe3be),
+	"Crop169":                               string(0xe3bc),
+	"Crop32":                                string(0xe3bd),
+	"Crop54":                                string(0xe3bf),
+	"Crop75":                                string(0xe3c0),
+	"CropDin":                               string(0xe3c1),
+	"CropFree":                              string(0xe3c2),
+	"CropLandscape":                         string(0xe3c3),
+	"CropOriginal":                          string(0xe3c4),
+	"CropPortrait":                          string(0xe3c5),
+	"CropRotate":                            string(0xe437),
+	"CropSquare":                            string(0xe3c6),
+	"Dashboard":                             string(0xe871),
+	"DataUsage":                             string(0xe1af),
+	"DateRange":                             string(0xe916),
+	"Dehaze":                                string(0xe3c7),
+	"Delete":                                string(0xe872),
+	"DeleteForever":                         string(0xe92b),
+	"DeleteSweep":                           string(0xe16c),
+	"Description":                           string(0xe873),
+	"DesktopMac":                            string(0xe30b),
+	"DesktopWindows":                        string(0xe30c),
+	"Details":                               string(0xe3c8),
+	"DeveloperBoard":                        string(0xe30d),
+	"DeveloperMode":                         string(0xe1b0),
+	"DeviceHub":                             string(0xe335),
+	"Devices":                               string(0xe1b1),
+	"DevicesOther":                          string(0xe337),
+	"DialerSip":                             string(0xe0bb),
+	"Dialpad":                               string(0xe0bc),
+	"Directions":                            string(0xe52e),
+	"DirectionsBike":                        string(0xe52f),
+	"DirectionsBoat":                        string(0xe532),
+	"DirectionsBus":                         string(0xe530),
+	"DirectionsCar":                         string(0xe531),
+	"DirectionsRailway":                     string(0xe534),
+	"DirectionsRun":                         string(0xe566),
+	"DirectionsSubway":                      string(0xe533),
+	"DirectionsTransit":                     string(0xe535),
+	"DirectionsWalk":                        string(0xe536),
+	"DiscFull":                              string(0xe610),
+	"Dns":                                   string(0xe875),
+	"DoNotDisturb":                          string(0xe612),
+	"DoNotDisturbAlt":                       string(0xe611),
+	"DoNotDisturbOff":                       string(0xe643),
+	"DoNotDisturbOn":                        string(0xe644),
+	"Dock":                                  string(0xe30e),
+	"Domain":                                string(0xe7ee),
+	"Done":                                  string(0xe876),
+	"DoneAll":                               string(0xe877),
+	"DonutLarge":                            string(0xe917),
+	"DonutSmall":                            string(0xe918),
+	"Drafts":                                string(0xe151),
+	"DragHandle":                            string(0xe25d),
+	"DriveEta":                              string(0xe613),
+	"Dvr":                                   string(0xe1b2),
+	"Edit":                                  string(0xe3c9),
+	"EditLocation":                          string(0xe568),
+	"Eject":                                 string(0xe8fb),
+	"Email":                                 string(0xe0be),
+	"EnhancedEncryption":                    string(0xe63f),
+	"Equalizer":                             string(0xe01d),
+	"Error":                                 string(0xe000),
+	"ErrorOutline":                          string(0xe001),
+	"EuroSymbol":                            string(0xe926),
+	"EvStation":                             string(0xe56d),
+	"Event":                                 string(0xe878),
+	"EventAvailable":                        string(0xe614),
+	"EventBusy":                             string(0xe615),
+	"EventNote":                             string(0xe616),
+	"EventSeat":                             string(0xe903),
+	"ExitToApp":                             string(0xe879),
+	"ExpandLess":                            string(0xe5ce),
+	"ExpandMore":                            string(0xe5cf),
+	"Explicit":                              string(0xe01e),
+	"Explore":                               string(0xe87a),
+	"Exposure":                              string(0xe3ca),
+	"ExposureNeg1":                          string(0xe3cb),
+	"ExposureNeg2":                          string(0xe3cc),
+	"ExposurePlus1":                         string(0xe3cd),
+	"ExposurePlus2":                         string(0xe3ce),
+	"ExposureZero":                          string(0xe3cf),
+	"Extension":                             string(0xe87b),
+	"Face":                                  string(0xe87c),
+	"FastForward":                           string(0xe01f),
+	"FastRewind":                            string(0xe020),
+	"Favorite":                              string(0xe87d),
+	"FavoriteBorder":                        string(0xe87e),
+	"FeaturedPlayList":                      string(0xe06d),
+	"FeaturedVideo":                         string(0xe06e),
+	"Feedback":                              string(0xe87f),
+	"FiberDvr":                              string(0xe05d),
+	"FiberManualRecord":                     string(0xe061),
+	"FiberNew":                              string(0xe05e),
+	"FiberPin":                              string(0xe06a),
+	"FiberSmartRecord":                      string(0xe062),
+	"FileDownload":                          string(0xe2c4),
+	"FileUpload":                            string(0xe2c6),
+	"Filter":                                string(0xe3d3),
+	"Filter1":                               string(0xe3d0),
+	"Filter2":                               string(0xe3d1),
+	"Filter3":                               string(0xe3d2),
+	"Filter4":                               string(0xe3d4),
+	"Filter5":                               string(0xe3d5),
+	"Filter6":                               string(0xe3d6),
+	"Filter7":                               string(0xe3d7),
+	"Filter8":                               string(0xe3d8),
+	"Filter9":                               string(0xe3d9),
+	"Filter9Plus":                           string(0xe3da),
+	"FilterBAndW":                           string(0xe3db),
+	"FilterCenterFocus":                     string(0xe3dc),
+	"FilterDrama":                           string(0xe3dd),
+	"FilterFrames":                          string(0xe3de),
+	"FilterHdr":                             string(0xe3df),
+	"FilterList":                            string(0xe152),
+	"FilterNone":                            string(0xe3e0),
+	"FilterTiltShift":                       string(0xe3e2),
+	"FilterVintage":                         string(0xe3e3),
+	"FindInPage":                            string(0xe880),
+	"FindReplace":                           string(0xe881),
+	"Fingerprint":                           string(0xe90d),
+	"FirstPage":                             string(0xe5dc),
+	"FitnessCenter":                         string(0xeb43),
+	"Flag":                                  string(0xe153),
+	"Flare":                                 string(0xe3e4),
+	"FlashAuto":                             string(0xe3e5),
+	"FlashOff":                              string(0xe3e6),
+	"FlashOn":                               string(0xe3e7),
+	"Flight":                                string(0xe539),
+	"FlightLand":                            string(0xe904),
+	"FlightTakeoff":                         string(0xe905),
+	"Flip":                                  string(0xe3e8),
+	"FlipToBack":                            string(0xe882),
+	"FlipToFront":                           string(0xe883),
+	"Folder":                                string(0xe2c7),
+	"FolderOpen":                            string(0xe2c8),
+	"FolderShared":                          string(0xe2c9),
+	"FolderSpecial":                         string(0xe617),
+	"FontDownload":                          string(0xe167),
+	"FormatAlignCenter":                     string(0xe234),
+	"FormatAlignJustify":                    string(0xe235),
+	"FormatAlignLeft":                       string(0xe236),
+	"FormatAlignRight":                      string(0xe237),
+	"FormatBold":                            string(0xe238),
+	"FormatClear":                           string(0xe239),
+	"FormatColorFill":                       string(0xe23a),
+	"FormatColorReset":                      string(0xe23b),
+	"FormatColorText":                       string(0xe23c),
+	"FormatIndentDecrease":                  string(0xe23d),
+	"FormatIndentIncrease":                  string(0xe23e),
+	"FormatItalic":                          string(0xe23f),
+	"FormatLineSpacing":                     string(0xe240),
+	"FormatListBulleted":                    string(0xe241),
+	"FormatListNumbered":                    string(0xe242),
+	"FormatPaint":                           string(0xe243),
+	"FormatQuote":                           string(0xe244),
+	"FormatShapes":                          string(0xe25e),
+	"FormatSize":                            string(0xe245),
+	"FormatStrikethrough":                   string(0xe246),
+	"FormatTextdirectionLToR":               string(0xe247),
+	"FormatTextdirectionRToL":               string(0xe248),
+	"FormatUnderlined":                      string(0xe249),
+	"Forum":                                 string(0xe0bf),
+	"Forward":                               string(0xe154),
+	"Forward10":                             string(0xe056),
+	"Forward30":                             string(0xe057),
+	"Forward5":                              string(0xe058),
+	"FreeBreakfast":                         string(0xeb44),
+	"Fullscreen":                            string(0xe5d0),
+	"FullscreenExit":                        string(0xe5d1),
+	"Functions":                             string(0xe24a),
+	"GTranslate":                            string(0xe927),
+	"Gamepad":                               string(0xe30f),
+	"Games":                                 string(0xe021),
+	"Gavel":                                 string(0xe90e),
+	"Gesture":                               string(0xe155),
+	"GetApp":                                string(0xe884),
+	"Gif":                                   string(0xe908),
+	"GolfCourse":                            string(0xeb45),
+	"GpsFixed":                              string(0xe1b3),
+	"GpsNotFixed":                           string(0xe1b4),
+	"GpsOff":                                string(0xe1b5),
+	"Grade":                                 string(0xe885),
+	"Gradient":                              string(0xe3e9),
+	"Grain":                                 string(0xe3ea),
+	"GraphicEq":                             string(0xe1b8),
+	"GridOff":                               string(0xe3eb),
+	"GridOn":                                string(0xe3ec),
+	"Group":                                 string(0xe7ef),
+	"GroupAdd":                              string(0xe7f0),
+	"GroupWork":                             string(0xe886),
+	"Hd":                                    string(0xe052),
+	"HdrOff":                                string(0xe3ed),
+	"HdrOn":                                 string(0xe3ee),
+	"HdrStrong":                             string(0xe3f1),
+	"HdrWeak":                               string(0xe3f2),
+	"Headset":                               string(0xe310),
+	"HeadsetMic":                            string(0xe311),
+	"Healing":                               string(0xe3f3),
+	"Hearing":                               string(0xe023),
+	"Help":                                  string(0xe887),
+	"HelpOutline":                           string(0xe8fd),
+	"HighQuality":                           string(0xe024),
+	"Highlight":                             string(0xe25f),
+	"HighlightOff":                          string(0xe888),
+	"History":                               string(0xe889),
+	"Home":                                  string(0xe88a),
+	"HotTub":                                string(0xeb46),
+	"Hotel":                                 string(0xe53a),
+	"HourglassEmpty":                        string(0xe88b),
+	"HourglassFull":                         string(0xe88c),
+	"Http":                                  string(0xe902),
+	"Https":                                 string(0xe88d),
+	"Image":                                 string(0xe3f4),
+	"ImageAspectRatio":                      string(0xe3f5),
+	"ImportContacts":                        string(0xe0e0),
+	"ImportExport":                          string(0xe0c3),
+	"ImportantDevices":                      string(0xe912),
+	"Inbox":                                 string(0xe156),
+	"IndeterminateCheckBox":                 string(0xe909),
+	"Info":                                  string(0xe88e),
+	"InfoOutline":                           string(0xe88f),
+	"Input":                                 string(0xe890),
+	"InsertChart":                           string(0xe24b),
+	"InsertComment":                         string(0xe24c),
+	"InsertDriveFile":                       string(0xe24d),
+	"InsertEmoticon":                        string(0xe24e),
+	"InsertInvitation":                      string(0xe24f),
+	"InsertLink":                            string(0xe250),
+	"InsertPhoto":                           string(0xe251),
+	"InvertColors":                          string(0xe891),
+	"InvertColorsOff":                       string(0xe0c4),
+	"Iso":                                   string(0xe3f6),
+	"Keyboard":                              string(0xe312),
+	"KeyboardArrowDown":                     string(0xe313),
+	"KeyboardArrowLeft":                     string(0xe314),
+	"KeyboardArrowRight":                    string(0xe315),
+	"KeyboardArrowUp":                       string(0xe316),
+	"KeyboardBackspace":                     string(0xe317),
+	"KeyboardCapslock":                      string(0xe318),
+	"KeyboardHide":                          string(0xe31a),
+	"KeyboardReturn":                        string(0xe31b),
+	"KeyboardTab":                           string(0xe31c),
+	"KeyboardVoice":                         string(0xe31d),
+	"Kitchen":                               string(0xeb47),
+	"Label":                                 string(0xe892),
+	"LabelOutline":                          string(0xe893),
+	"Landscape":                             string(0xe3f7),
+	"Language":                              string(0xe894),
+	"Laptop":                                string(0xe31e),
+	"LaptopChromebook":                      string(0xe31f),
+	"LaptopMac":                             string(0xe320),
+	"LaptopWindows":                         string(0xe321),
+	"LastPage":                              string(0xe5dd),
+	"Launch":                                string(0xe895),
+	"Layers":                                string(0xe53b),
+	"LayersClear":                           string(0xe53c),
+	"LeakAdd":                               string(0xe3f8),
+	"LeakRemove":                            string(0xe3f9),
+	"Lens":                                  string(0xe3fa),
+	"LibraryAdd":                            string(0xe02e),
+	"LibraryBooks":                          string(0xe02f),
+	"LibraryMusic":                          string(0xe030),
+	"LightbulbOutline":                      string(0xe90f),
+	"LineStyle":                             string(0xe919),
+	"LineWeight":                            string(0xe91a),
+	"LinearScale":                           string(0xe260),
+	"Link":                                  string(0xe157),
+	"LinkedCamera":                          string(0xe438),
+	"List":                                  string(0xe896),
+	"LiveHelp":                              string(0xe0c6),
+	"LiveTv":                                string(0xe639),
+	"LocalActivity":                         string(0xe53f),
+	"LocalAirport":                          string(0xe53d),
+	"LocalAtm":                              string(0xe53e),
+	"LocalBar":                              string(0xe540),
+	"LocalCafe":                             string(0xe541),
+	"LocalCarWash":                          string(0xe542),
+	"LocalConvenienceStore":                 string(0xe543),
+	"LocalDining":                           string(0xe556),
+	"LocalDrink":                            string(0xe544),
+	"LocalFlorist":                          string(0xe545),
+	"LocalGasStation":                       string(0xe546),
+	"LocalGroceryStore":                     string(0xe547),
+	"LocalHospital":                         string(0xe548),
+	"LocalHotel":                            string(0xe549),
+	"LocalLaundryService":                   string(0xe54a),
+	"LocalLibrary":                          string(0xe54b),
+	"LocalMall":                             string(0xe54c),
+	"LocalMovies":                           string(0xe54d),
+	"LocalOffer":                            string(0xe54e),
+	"LocalParking":                          string(0xe54f),
+	"LocalPharmacy":                         string(0xe550),
+	"LocalPhone":                            string(0xe551),
+	"LocalPizza":                            string(0xe552),
+	"LocalPlay":                             string(0xe553),
+	"LocalPostOffice":                       string(0xe554),
+	"LocalPrintshop":                        string(0xe555),
+	"LocalSee":                              string(0xe557),
+	"LocalShipping":                         string(0xe558),
+	"LocalTaxi":                             string(0xe559),
+	"LocationCity":                          string(0xe7f1),
+	"LocationDisabled":                      string(0xe1b6),
+	"LocationOff":                           string(0xe0c7),
+	"LocationOn":                            string(0xe0c8),
+	"LocationSearching":                     string(0xe1b7),
+	"Lock":                                  string(0xe897),
+	"LockOpen":                              string(0xe898),
+	"LockOutline":                           string(0xe899),
+	"Looks":                                 string(0xe3fc),
+	"Looks3":                                string(0xe3fb),
+	"Looks4":                                string(0xe3fd),
+	"Looks5":                                string(0xe3fe),
+	"Looks6":                                string(0xe3ff),
+	"LooksOne":                              string(0xe400),
+	"LooksTwo":                              string(0xe401),
+	"Loop":                                  string(0xe028),
+	"Loupe":                                 string(0xe402),
+	"LowPriority":                           string(0xe16d),
+	"Loyalty":                               string(0xe89a),
+	"Mail":                                  string(0xe158),
+	"MailOutline":                           string(0xe0e1),
+	"Map":                                   string(0xe55b),
+	"Markunread":                            string(0xe159),
+	"MarkunreadMailbox":                     string(0xe89b),
+	"Memory":                                string(0xe322),
+	"Menu":                                  string(0xe5d2),
+	"MergeType":                             string(0xe252),
+	"Message":                               string(0xe0c9),
+	"Mic":                                   string(0xe029),
+	"MicNone":                               string(0xe02a),
+	"MicOff":                                string(0xe02b),
+	"Mms":                                   string(0xe618),
+	"ModeComment":                           string(0xe253),
+	"ModeEdit":                              string(0xe254),
+	"MonetizationOn":                        string(0xe263),
+	"MoneyOff":                              string(0xe25c),
+	"MonochromePhotos":                      string(0xe403),
+	"Mood":                                  string(0xe7f2),
+	"MoodBad":                               string(0xe7f3),
+	"More":                                  string(0xe619),
+	"MoreHoriz":                             string(0xe5d3),
+	"MoreVert":                              string(0xe5d4),
+	"Motorcycle":                            string(0xe91b),
+	"Mouse":                                 string(0xe323),
+	"MoveToInbox":                           string(0xe168),
+	"Movie":                                 string(0xe02c),
+	"MovieCreation":                         string(0xe404),
+	"MovieFilter":                           string(0xe43a),
+	"MultilineChart":                        string(0xe6df),
+	"MusicNote":                             string(0xe405),
+	"MusicVideo":                            string(0xe063),
+	"MyLocation":                            string(0xe55c),
+	"Nature":                                string(0xe406),
+	"NaturePeople":                          string(0xe407),
+	"NavigateBefore":                        string(0xe408),
+	"NavigateNext":                          string(0xe409),
+	"Navigation":                            string(0xe55d),
+	"NearMe":                                string(0xe569),
+	"NetworkCell":                           string(0xe1b9),
+	"NetworkCheck":                          string(0xe640),
+	"NetworkLocked":                         string(0xe61a),
+	"NetworkWifi":                           string(0xe1ba),
+	"NewReleases":                           string(0xe031),
+	"NextWeek":                              string(0xe16a),
+	"Nfc":                                   string(0xe1bb),
+	"NoEncryption":                          string(0xe641),
+	"NoSim":                                 string(0xe0cc),
+	"NotInterested":                         string(0xe033),
+	"Note":                                  string(0xe06f),
+	"NoteAdd":                               string(0xe89c),
+	"Notifications":                         string(0xe7f4),
+	"NotificationsActive":                   string(0xe7f7),
+	"NotificationsNone":                     string(0xe7f5),
+	"NotificationsOff":                      string(0xe7f6),
+	"NotificationsPaused":                   string(0xe7f8),
+	"OfflinePin":                            string(0xe90a),
+	"OndemandVideo":                         string(0xe63a),
+	"Opacity":                               string(0xe91c),
+	"OpenInBrowser":                         string(0xe89d),
+	"OpenInNew":                             string(0xe89e),
+	"OpenWith":                              string(0xe89f),
+	"Pages":                                 string(0xe7f9),
+	"Pageview":                              string(0xe8a0),
+	"Palette":                               string(0xe40a),
+	"PanTool":                               string(0xe925),
+	"Panorama":                              string(0xe40b),
+	"PanoramaFishEye":                       string(0xe40c),
+	"PanoramaHorizontal":                    string(0xe40d),
+	"PanoramaVertical":                      string(0xe40e),
+	"PanoramaWideAngle":                     string(0xe40f),
+	"PartyMode":                             string(0xe7fa),
+	"Pause":                                 string(0xe034),
+	"PauseCircleFilled":                     string(0xe035),
+	"PauseCircleOutline":                    string(0xe036),
+	"Payment":                               string(0xe8a1),
+	"People":                                string(0xe7fb),
+	"PeopleOutline":                         string(0xe7fc),
+	"PermCameraMic":                         string(0xe8a2),
+	"PermContactCalendar":                   string(0xe8a3),
+	"PermDataSetting":                       string(0xe8a4),
+	"PermDeviceInformation":                 string(0xe8a5),
+	"PermIdentity":                          string(0xe8a6),
+	"PermMedia":                             string(0xe8a7),
+	"PermPhoneMsg":                          string(0xe8a8),
+	"PermScanWifi":                          string(0xe8a9),
+	"Person":                                string(0xe7fd),
+	"PersonAdd":                             string(0xe7fe),
+	"PersonOutline":                         string(0xe7ff),
+	"PersonPin":                             string(0xe55a),
+	"PersonPinCircle":                       string(0xe56a),
+	"PersonalVideo":                         string(0xe63b),
+	"Pets":                                  string(0xe91d),
+	"Phone":                                 string(0xe0cd),
+	"PhoneAndroid":                          string(0xe324),
+	"PhoneBluetoothSpeaker":                 string(0xe61b),
+	"PhoneForwarded":                        string(0xe61c),
+	"PhoneInTalk":                           string(0xe61d),
+	"PhoneIphone":                           string(0xe325),
+	"PhoneLocked":                           string(0xe61e),
+	"PhoneMissed":                           string(0xe61f),
+	"PhonePaused":                           string(0xe620),
+	"Phonelink":                             string(0xe326),
+	"PhonelinkErase":                        string(0xe0db),
+	"PhonelinkLock":                         string(0xe0dc),
+	"PhonelinkOff":                          string(0xe327),
+	"PhonelinkRing":                         string(0xe0dd),
+	"PhonelinkSetup":                        string(0xe0de),
+	"Photo":                                 string(0xe410),
+	"PhotoAlbum":                            string(0xe411),
+	"PhotoCamera":                           string(0xe412),
+	"PhotoFilter":                           string(0xe43b),
+	"PhotoLibrary":                          string(0xe413),
+	"PhotoSizeSelectActual":                 string(0xe432),
+	"PhotoSizeSelectLarge":                  string(0xe433),
+	"PhotoSizeSelectSmall":                  string(0xe434),
+	"PictureAsPdf":                          string(0xe415),
+	"PictureInPicture":                      string(0xe8aa),
+	"PictureInPictureAlt":                   string(0xe911),
+	"PieChart":                              string(0xe6c4),
+	"PieChartOutlined":                      string(0xe6c5),
+	"PinDrop":                               string(0xe55e),
+	"Place":                                 string(0xe55f),
+	"PlayArrow":                             string(0xe037),
+	"PlayCircleFilled":                      string(0xe038),
+	"PlayCircleOutline":                     string(0xe039),
+	"PlayForWork":                           string(0xe906),
+	"PlaylistAdd":                           string(0xe03b),
+	"PlaylistAddCheck":                      string(0xe065),
+	"PlaylistPlay":                          string(0xe05f),
+	"PlusOne":                               string(0xe800),
+	"Poll":                                  string(0xe801),
+	"Polymer":                               string(0xe8ab),
+	"Pool":                                  string(0xeb48),
+	"PortableWifiOff":                       string(0xe0ce),
+	"Portrait":                              string(0xe416),
+	"Power":                                 string(0xe63c),
+	"PowerInput":                            string(0xe336),
+	"PowerSettingsNew":                      string(0xe8ac),
+	"PregnantWoman":                         string(0xe91e),
+	"PresentToAll":                          string(0xe0df),
+	"Print":                                 string(0xe8ad),
+	"PriorityHigh":                          string(0xe645),
+	"Public":                                string(0xe80b),
+	"Publish":                               string(0xe255),
+	"QueryBuilder":                          string(0xe8ae),
+	"QuestionAnswer":                        string(0xe8af),
+	"Queue":                                 string(0xe03c),
+	"QueueMusic":                            string(0xe03d),
+	"QueuePlayNext":                         string(0xe066),
+	"Radio":                                 string(0xe03e),
+	"RadioButtonChecked":                    string(0xe837),
+	"RadioButtonUnchecked":                  string(0xe836),
+	"RateReview":                            string(0xe560),
+	"Receipt":                               string(0xe8b0),
+	"RecentActors":                          string(0xe03f),
+	"RecordVoiceOver":                       string(0xe91f),
+	"Redeem":                                string(0xe8b1),
+	"Redo":                                  string(0xe15a),
+	"Refresh":                               string(0xe5d5),
+	"Remove":                                string(0xe15b),
+	"RemoveCircle":                          string(0xe15c),
+	"RemoveCircleOutline":                   string(0xe15d),
+	"RemoveFromQueue":                       string(0xe067),
+	"RemoveRedEye":                          string(0xe417),
+	"RemoveShoppingCart":                    string(0xe928),
+	"Reorder":                               string(0xe8fe),
+	"Repeat":                                string(0xe040),
+	"RepeatOne":                             string(0xe041),
+	"Replay":                                string(0xe042),
+	"Replay10":                              string(0xe059),
+	"Replay30":                              string(0xe05a),
+	"Replay5":                               string(0xe05b),
+	"Reply":                                 string(0xe15e),
+	"ReplyAll":                              string(0xe15f),
+	"Report":                                string(0xe160),
+	"ReportProblem":                         string(0xe8b2),
+	"Restaurant":                            string(0xe56c),
+	"RestaurantMenu":                        string(0xe561),
+	"Restore":                               string(0xe8b3),
+	"RestorePage":                           string(0xe929),
+	"RingVolume":                            string(0xe0d1),
+	"Room":                                  string(0xe8b4),
+	"RoomService":                           string(0xeb49),
+	"Rotate90DegreesCcw":                    string(0xe418),
+	"RotateLeft":                            string(0xe419),
+	"RotateRight":                           string(0xe41a),
+	"RoundedCorner":                         string(0xe920),
+	"Router":                                string(0xe328),
+	"Rowing":                                string(0xe921),
+	"RssFeed":                               string(0xe0e5),
+	"RvHookup":                              string(0xe642),
+	"Satellite":                             string(0xe562),
+	"Save":                                  string(0xe161),
+	"Scanner":                               string(0xe329),
+	"Schedule":                              string(0xe8b5),
+	"School":                                string(0xe80c),
+	"ScreenLockLandscape":                   string(0xe1be),
+	"ScreenLockPortrait":                    string(0xe1bf),
+	"ScreenLockRotation":                    string(0xe1c0),
+	"ScreenRotation":                        string(0xe1c1),
+	"ScreenShare":                           string(0xe0e2),
+	"SdCard":                                string(0xe623),
+	"SdStorage":                             string(0xe1c2),
+	"Search":                                string(0xe8b6),
+	"Security":                              string(0xe32a),
+	"SelectAll":                             string(0xe162),
+	"Send":                                  string(0xe163),
+	"SentimentDissatisfied":                 string(0xe811),
+	"SentimentNeutral":                      string(0xe812),
+	"SentimentSatisfied":                    string(0xe813),
+	"SentimentVeryDissatisfied":             string(0xe814),
+	"SentimentVerySatisfied":                string(0xe815),
+	"Settings":                              string(0xe8b8),
+	"SettingsApplications":                  string(0xe8b9),
+	"SettingsBackupRestore":                 string(0xe8ba),
+	"SettingsBluetooth":                     string(0xe8bb),
+	"SettingsBrightness":                    string(0xe8bd),
+	"SettingsCell":                          string(0xe8bc),
+	"SettingsEthernet":                      string(0xe8be),
+	"SettingsInputAntenna":                  string(0xe8bf),
+	"SettingsInputComponent":                string(0xe8c0),
+	"SettingsInputComposite":                string(0xe8c1),
+	"SettingsInputHdmi":                     string(0xe8c2),
+	"SettingsInputSvideo":                   string(0xe8c3),
+	"SettingsOverscan":                      string(0xe8c4),
+	"SettingsPhone":                         string(0xe8c5),
+	"SettingsPower":                         string(0xe8c6),
+	"SettingsRemote":                        string(0xe8c7),
+	"SettingsSystemDaydream":                string(0xe1c3),
+	"SettingsVoice":                         string(0xe8c8),
+	"Share":                                 string(0xe80d),
+	"Shop":                                  string(0xe8c9),
+	"ShopTwo":                               string(0xe8ca),
+	"ShoppingBasket":                        string(0xe8cb),
+	"ShoppingCart":                          string(0xe8cc),
+	"ShortText":                             string(0xe261),
+	"ShowChart":                             string(0xe6e1),
+	"Shuffle":                               string(0xe043),
+	"SignalCellular4Bar":                    string(0xe1c8),
+	"SignalCellularConnectedNoInternet4Bar": string(0xe1cd),
+	"SignalCellularNoSim":                   string(0xe1ce),
+	"SignalCellularNull":                    string(0xe1cf),
+	"SignalCellularOff":                     string(0xe1d0),
+	"SignalWifi4Bar":                        string(0xe1d8),
+	"SignalWifi4BarLock":                    string(0xe1d9),
+	"SignalWifiOff":                         string(0xe1da),
+	"SimCard":                               string(0xe32b),
+	"SimCardAlert":                          string(0xe624),
+	"SkipNext":                              string(0xe044),
+	"SkipPrevious":                          string(0xe045),
+	"Slideshow":                             string(0xe41b),
+	"SlowMotionVideo":                       string(0xe068),
+	"Smartphone":                            string(0xe32c),
+	"SmokeFree":                             string(0xeb4a),
+	"SmokingRooms":                          string(0xeb4b),
+	"Sms":                                   string(0xe625),
+	"SmsFailed":                             string(0xe626),
+	"Snooze":                                string(0xe046),
+	"Sort":                                  string(0xe164),
+	"SortByAlpha":                           string(0xe053),
+	"Spa":                                   string(0xeb4c),
+	"SpaceBar":                              string(0xe256),
+	"Speaker":                               string(0xe32d),
+	"SpeakerGroup":                          string(0xe32e),
+	"SpeakerNotes":                          string(0xe8cd),
+	"SpeakerNotesOff":                       string(0xe92a),
+	"SpeakerPhone":                          string(0xe0d2),
+	"Spellcheck":                            string(0xe8ce),
+	"Star":                                  string(0xe838),
+	"StarBorder":                            string(0xe83a),
+	"StarHalf":                              string(0xe839),
+	"Stars":                                 string(0xe8d0),
+	"StayCurrentLandscape":                  string(0xe0d3),
+	"StayCurrentPortrait":                   string(0xe0d4),
+	"StayPrimaryLandscape":                  string(0xe0d5),
+	"StayPrimaryPortrait":                   string(0xe0d6),
+	"Stop":                                  string(0xe047),
+	"StopScreenShare":                       string(0xe0e3),
+	"Storage":                               string(0xe1db),
+	"Store":                                 string(0xe8d1),
+	"StoreMallDirectory":                    string(0xe563),
+	"Straighten":                            string(0xe41c),
+	"Streetview":                            string(0xe56e),
+	"StrikethroughS":                        string(0xe257),
+	"Style":                                 string(0xe41d),
+	"SubdirectoryArrowLeft":                 string(0xe5d9),
+	"SubdirectoryArrowRight":                string(0xe5da),
+	"Subject":                               string(0xe8d2),
+	"Subscriptions":                         string(0xe064),
+	"Subtitles":                             string(0xe048),
+	"Subway":                                string(0xe56f),
+	"SupervisorAccount":                     string(0xe8d3),
+	"SurroundSound":                         string(0xe049),
+	"SwapCalls":                             string(0xe0d7),
+	"SwapHoriz":                             string(0xe8d4),
+	"SwapVert":                              string(0xe8d5),
+	"SwapVerticalCircle":                    string(0xe8d6),
+	"SwitchCamera":                          string(0xe41e),
+	"SwitchVideo":                           string(0xe41f),
+	"Sync":                                  string(0xe627),
+	"SyncDisabled":                          string(0xe628),
+	"SyncProblem":                           string(0xe629),
+	"SystemUpdate":                          string(0xe62a),
+	"SystemUpdateAlt":                       string(0xe8d7),
+	"Tab":                                   string(0xe8d8),
+	"TabUnselected":                         string(0xe8d9),
+	"Tablet":                                string(0xe32f),
+	"TabletAndroid":                         string(0xe330),
+	"TabletMac":                             string(0xe331),
+	"TagFaces":                              string(0xe420),
+	"TapAndPlay":                            string(0xe62b),
+	"Terrain":                               string(0xe564),
+	"TextFields":                            string(0xe262),
+	"TextFormat":                            string(0xe165),
+	"Textsms":                               string(0xe0d8),
+	"Texture":                               string(0xe421),
+	"Theaters":                              string(0xe8da),
+	"ThumbDown":                             string(0xe8db),
+	"ThumbUp":                               string(0xe8dc),
+	"ThumbsUpDown":                          string(0xe8dd),
+	"TimeToLeave":                           string(0xe62c),
+	"Timelapse":                             string(0xe422),
+	"Timeline":                              string(0xe922),
+	"Timer":                                 string(0xe425),
+	"Timer10":                               string(0xe423),
+	"Timer3":                                string(0xe424),
+	"TimerOff":                              string(0xe426),
+	"Title":                                 string(0xe264),
+	"Toc":                                   string(0xe8de),
+	"Today":                                 string(0xe8df),
+	"Toll":                                  string(0xe8e0),
+	"Tonality":                              string(0xe427),
+	"TouchApp":                              string(0xe913),
+	"Toys":                                  string(0xe332),
+	"TrackChanges":                          string(0xe8e1),
+	"Traffic":                               string(0xe565),
+	"Train":                                 string(0xe570),
+	"Tram":                                  string(0xe571),
+	"TransferWithinAStation":                string(0xe572),
+	"Transform":                             string(0xe428),
+	"Translate":                             string(0xe8e2),
+	"TrendingDown":                          string(0xe8e3),
+	"TrendingFlat":                          string(0xe8e4),
+	"TrendingUp":                            string(0xe8e5),
+	"Tune":                                  string(0xe429),
+	"TurnedIn":                              string(0xe8e6),
+	"TurnedInNot":                           string(0xe8e7),
+	"Tv":                                    string(0xe333),
+	"Unarchive":                             string(0xe169),
+	"Undo":                                  string(0xe166),
+	"UnfoldLess":                            string(0xe5d6),
+	"UnfoldMore":                            string(0xe5d7),
+	"Update":                                string(0xe923),
+	"Usb":                                   string(0xe1e0),
+	"VerifiedUser":                          string(0xe8e8),
+	"VerticalAlignBottom":                   string(0xe258),
+	"VerticalAlignCenter":                   string(0xe259),
+	"VerticalAlignTop":                      string(0xe25a),
+	"Vibration":                             string(0xe62d),
+	"VideoCall":                             string(0xe070),
+	"VideoLabel":                            string(0xe071),
+	"VideoLibrary":                          string(0xe04a),
+	"Videocam":                              string(0xe04b),
+	"VideocamOff":                           string(0xe04c),
+	"VideogameAsset":                        string(0xe338),
+	"ViewAgenda":                            string(0xe8e9),
+	"ViewArray":                             string(0xe8ea),
+	"ViewCarousel":                          string(0xe8eb),
+	"ViewColumn":                            string(0xe8ec),
+	"ViewComfy":                             string(0xe42a),
+	"ViewCompact":                           string(0xe42b),
+	"ViewDay":                               string(0xe8ed),
+	"ViewHeadline":                          string(0xe8ee),
+	"ViewList":                              string(0xe8ef),
+	"ViewModule":                            string(0xe8f0),
+	"ViewQuilt":                             string(0xe8f1),
+	"ViewStream":                            string(0xe8f2),
+	"ViewWeek":                              string(0xe8f3),
+	"Vignette":                              string(0xe435),
+	"Visibility":                            string(0xe8f4),
+	"VisibilityOff":                         string(0xe8f5),
+	"VoiceChat":                             string(0xe62e),
+	"Voicemail":                             string(0xe0d9),
+	"VolumeDown":                            string(0xe04d),
+	"VolumeMute":                            string(0xe04e),
+	"VolumeOff":                             string(0xe04f),
+	"VolumeUp":                              string(0xe050),
+	"VpnKey":                                string(0xe0da),
+	"VpnLock":                               string(0xe62f),
+	"Wallpaper":                             string(0xe1bc),
+	"Warning":                               string(0xe002),
+	"Watch":                                 string(0xe334),
+	"WatchLater":                            string(0xe924),
+	"WbAuto":                                string(0xe42c),
+	"WbCloudy":                              string(0xe42d),
+	"WbIncandescent":                        string(0xe42e),
+	"WbIridescent":                          string(0xe436),
+	"WbSunny":                               string(0xe430),
+	"Wc":                                    string(0xe63d),
+	"Web":                                   string(0xe051),
+	"WebAsset":                              string(0xe069),
+	"Weekend":                               string(0xe16b),
+	"Whatshot":                              string(0xe80e),
+	"Widgets":                               string(0xe1bd),
+	"Wifi":                                  string(0xe63e),
+	"WifiLock":                              string(0xe1e1),
+	"WifiTethering":                         string(0xe1e2),
+	"Work":                                  string(0xe8f9),
+	"WrapText":                              string(0xe25b),
+	"YoutubeSearchedFor":                    string(0xe8fa),
+	"ZoomIn":                                string(0xe8ff),
+	"ZoomOut":                               string(0xe900),
+	"ZoomOutMap":                            string(0xe56b),
+}
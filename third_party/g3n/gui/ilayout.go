@@ -0,0 +1,10 @@
+// Copyright 2016 The G3N Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gui
+
+// ILayout is the interface for layouts
+type ILayout interface {
+	Recalc(ipan IPanel)
+}
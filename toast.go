@@ -0,0 +1,108 @@
+package main
+
+import (
+	"time"
+
+	"github.com/g3n/engine/gui"
+	"github.com/g3n/engine/math32"
+)
+
+type toast struct {
+	label *gui.Label
+	shown time.Time
+}
+
+// Toasts shows short notifications which fade out by themselves, the newest one is at the top
+type Toasts struct {
+	panel *gui.Panel
+	items []*toast
+	scale float32
+
+	// configs
+	Duration time.Duration // how long a toast is fully visible
+	FadeTime time.Duration
+	MaxCount int
+	FontSize float64 // unscaled font size
+	Spacing  float32
+}
+
+func NewToasts() (ts *Toasts) {
+	ts = new(Toasts)
+	ts.scale = 1
+	ts.Duration = 4 * time.Second
+	ts.FadeTime = time.Second
+	ts.MaxCount = 4
+	ts.FontSize = gui.StyleDefault().Label.PointSize * 1.25
+	ts.Spacing = 4
+
+	ts.panel = gui.NewPanel(0, 0)
+	ts.panel.SetEnabled(false)
+	return
+}
+
+// Panel returns the gui panel which contains the toasts
+func (ts *Toasts) Panel() gui.IPanel {
+	return ts.panel
+}
+
+func (ts *Toasts) SetScale(scale float32) {
+	ts.scale = scale
+	for _, t := range ts.items {
+		t.label.SetFontSize(ts.FontSize * (float64)(scale))
+	}
+}
+
+// Show adds a toast, the oldest one is removed if there are too many
+func (ts *Toasts) Show(text string) {
+	uiLog.Info("toast", "text", text)
+	t := &toast{
+		label: gui.NewLabel(text),
+		shown: time.Now(),
+	}
+	t.label.SetFontSize(ts.FontSize * (float64)(ts.scale))
+	t.label.SetPaddings(4, 8, 4, 8)
+	t.label.SetBgColor4(&math32.Color4{0.1, 0.1, 0.1, 0.7})
+	ts.panel.Add(t.label)
+	ts.items = append([]*toast{t}, ts.items...)
+	if ts.MaxCount > 0 && len(ts.items) > ts.MaxCount {
+		for _, old := range ts.items[ts.MaxCount:] {
+			ts.panel.Remove(old.label)
+			old.label.Dispose()
+		}
+		ts.items = ts.items[:ts.MaxCount]
+	}
+}
+
+func (ts *Toasts) update(now time.Time) {
+	n := 0
+	for _, t := range ts.items {
+		if age := now.Sub(t.shown); age < ts.Duration+ts.FadeTime {
+			ts.items[n] = t
+			n++
+			continue
+		}
+		ts.panel.Remove(t.label)
+		t.label.Dispose()
+	}
+	clear(ts.items[n:])
+	ts.items = ts.items[:n]
+
+	var width, y float32
+	for _, t := range ts.items {
+		alpha := float32(1)
+		if age := now.Sub(t.shown); age > ts.Duration && ts.FadeTime > 0 {
+			alpha = 1 - (float32)(age-ts.Duration)/(float32)(ts.FadeTime)
+		}
+		t.label.SetColor4(&math32.Color4{1, 1, 1, alpha})
+		t.label.SetBgColor4(&math32.Color4{0.1, 0.1, 0.1, 0.7 * alpha})
+		if w := t.label.Width(); w > width {
+			width = w
+		}
+		t.label.SetPositionY(y)
+		y += t.label.Height() + ts.Spacing*ts.scale
+	}
+	for _, t := range ts.items {
+		t.label.SetPositionX((width - t.label.Width()) / 2)
+	}
+	ts.panel.SetSize(width, y)
+}
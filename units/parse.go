@@ -0,0 +1,60 @@
+package units
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Parse parses a number followed by an optional unit symbol of the scales,
+// the value without a symbol is in base units.
+func Parse(s string, scales ...Scale) (float64, error) {
+	s = strings.TrimSpace(s)
+	end := len(s)
+	for end > 0 && !isNumberByte(s[end-1]) {
+		end--
+	}
+	num, symbol := s[:end], strings.TrimSpace(s[end:])
+	v, err := strconv.ParseFloat(num, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid number %q", num)
+	}
+	if symbol == "" {
+		return v, nil
+	}
+	for _, scale := range scales {
+		for _, u := range scale {
+			if u.Symbol == symbol {
+				return v * u.Size, nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("unknown unit %q", symbol)
+}
+
+func isNumberByte(b byte) bool {
+	return '0' <= b && b <= '9' || b == '.'
+}
+
+func allScales(pick func(*System) Scale) []Scale {
+	scales := make([]Scale, len(Systems))
+	for i, s := range Systems {
+		scales[i] = pick(s)
+	}
+	return scales
+}
+
+// ParseDistance parses a distance with any builtin unit into meters
+func ParseDistance(s string) (float64, error) {
+	return Parse(s, allScales(func(s *System) Scale { return s.Distance })...)
+}
+
+// ParseSpeed parses a speed with any builtin unit into meters per second
+func ParseSpeed(s string) (float64, error) {
+	return Parse(s, allScales(func(s *System) Scale { return s.Speed })...)
+}
+
+// ParseDuration parses a duration with any builtin unit into seconds
+func ParseDuration(s string) (float64, error) {
+	return Parse(s, timeScale)
+}
@@ -0,0 +1,243 @@
+// Package units formats physical quantities with human readable units
+package units
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+const (
+	Meter            = 1.0
+	Kilometer        = 1e3 * Meter
+	Megameter        = 1e6 * Meter
+	Gigameter        = 1e9 * Meter
+	Terameter        = 1e12 * Meter
+	Petameter        = 1e15 * Meter
+	Foot             = 0.3048 * Meter
+	Mile             = 1609.344 * Meter
+	AstronomicalUnit = 1.495978707e11 * Meter
+	LightYear        = 9.4607304725808e15 * Meter
+
+	SpeedOfLight = 299792458.0
+
+	Second = 1.0
+	Minute = 60 * Second
+	Hour   = 60 * Minute
+	Day    = 24 * Hour
+	Year   = 365.25 * Day
+)
+
+// Unit is a display unit of a quantity
+type Unit struct {
+	Symbol string
+	Size   float64 // how many base units one of this unit is
+	// Min is the smallest absolute value (in base units) which uses this unit,
+	// zero means the unit is used from its Size
+	Min float64
+	// ExtraDecimals is added to the formatter's decimals, it's useful for units like c
+	// where the interesting values are far below one
+	ExtraDecimals int
+}
+
+func (u *Unit) min() float64 {
+	if u.Min > 0 {
+		return u.Min
+	}
+	return u.Size
+}
+
+// Scale is a list of units which are sorted from the smallest to the largest
+type Scale []Unit
+
+// pick returns the index of the largest unit which can represent v
+func (s Scale) pick(v float64) int {
+	v = math.Abs(v)
+	i := 0
+	for j := 1; j < len(s); j++ {
+		if v >= s[j].min() {
+			i = j
+		}
+	}
+	return i
+}
+
+// Format formats v (in base units) with the most suitable unit in the scale
+func (s Scale) Format(v float64, decimals int) string {
+	if len(s) == 0 {
+		return strconv.FormatFloat(v, 'f', decimals, 64)
+	}
+	switch {
+	case math.IsNaN(v):
+		return "NaN"
+	case math.IsInf(v, 1):
+		return "∞"
+	case math.IsInf(v, -1):
+		return "-∞"
+	}
+	i := s.pick(v)
+	for {
+		u := &s[i]
+		d := decimals + u.ExtraDecimals
+		if d < 0 {
+			d = 0
+		}
+		scaled := roundTo(v/u.Size, d)
+		// the rounding may carry the value into the next unit, e.g. 999.996 m to 1000.00 m
+		if i+1 < len(s) && math.Abs(scaled)*u.Size >= s[i+1].min() {
+			i++
+			continue
+		}
+		if scaled == 0 {
+			scaled = 0 // drop the negative zero
+		}
+		return strconv.FormatFloat(scaled, 'f', d, 64) + " " + u.Symbol
+	}
+}
+
+func roundTo(v float64, decimals int) float64 {
+	p := math.Pow10(decimals)
+	return math.Round(v*p) / p
+}
+
+// System is a set of unit scales
+type System struct {
+	Name     string
+	Distance Scale
+	Speed    Scale
+	Duration Scale
+}
+
+var timeScale = Scale{
+	{Symbol: "s", Size: Second},
+	{Symbol: "min", Size: Minute},
+	{Symbol: "h", Size: Hour},
+	{Symbol: "d", Size: Day},
+	{Symbol: "yr", Size: Year},
+}
+
+var (
+	// Astronomical uses AU and light years for the large distances and fraction of c for the high speeds
+	Astronomical = &System{
+		Name: "astronomical",
+		Distance: Scale{
+			{Symbol: "m", Size: Meter},
+			{Symbol: "km", Size: Kilometer},
+			{Symbol: "Mm", Size: Megameter},
+			{Symbol: "AU", Size: AstronomicalUnit, Min: 0.01 * AstronomicalUnit},
+			{Symbol: "ly", Size: LightYear, Min: 0.1 * LightYear},
+		},
+		Speed: Scale{
+			{Symbol: "m/s", Size: Meter},
+			{Symbol: "km/s", Size: Kilometer},
+			{Symbol: "c", Size: SpeedOfLight, Min: 0.01 * SpeedOfLight, ExtraDecimals: 2},
+		},
+		Duration: timeScale,
+	}
+	// Metric only uses SI prefixed units
+	Metric = &System{
+		Name: "metric",
+		Distance: Scale{
+			{Symbol: "m", Size: Meter},
+			{Symbol: "km", Size: Kilometer},
+			{Symbol: "Mm", Size: Megameter},
+			{Symbol: "Gm", Size: Gigameter},
+			{Symbol: "Tm", Size: Terameter},
+			{Symbol: "Pm", Size: Petameter},
+		},
+		Speed: Scale{
+			{Symbol: "m/s", Size: Meter},
+			{Symbol: "km/s", Size: Kilometer},
+			{Symbol: "Mm/s", Size: Megameter},
+		},
+		Duration: timeScale,
+	}
+	// Imperial uses feet and miles for the short distances
+	Imperial = &System{
+		Name: "imperial",
+		Distance: Scale{
+			{Symbol: "ft", Size: Foot},
+			{Symbol: "mi", Size: Mile},
+			{Symbol: "AU", Size: AstronomicalUnit, Min: 0.01 * AstronomicalUnit},
+			{Symbol: "ly", Size: LightYear, Min: 0.1 * LightYear},
+		},
+		Speed: Scale{
+			{Symbol: "ft/s", Size: Foot},
+			{Symbol: "mi/s", Size: Mile},
+			{Symbol: "c", Size: SpeedOfLight, Min: 0.01 * SpeedOfLight, ExtraDecimals: 2},
+		},
+		Duration: timeScale,
+	}
+)
+
+// Systems lists all builtin unit systems
+var Systems = []*System{Astronomical, Metric, Imperial}
+
+// ParseSystem returns the builtin system with the name, or nil if not found
+func ParseSystem(name string) *System {
+	for _, s := range Systems {
+		if strings.EqualFold(s.Name, name) {
+			return s
+		}
+	}
+	return nil
+}
+
+// Formatter formats quantities with a unit system
+type Formatter struct {
+	System   *System
+	Decimals int
+}
+
+func (f *Formatter) system() *System {
+	if f.System == nil {
+		return Astronomical
+	}
+	return f.System
+}
+
+// Distance formats a distance in meters
+func (f *Formatter) Distance(m float64) string {
+	return f.system().Distance.Format(m, f.Decimals)
+}
+
+// Speed formats a speed in meters per second
+func (f *Formatter) Speed(mps float64) string {
+	return f.system().Speed.Format(mps, f.Decimals)
+}
+
+// Duration formats a duration in seconds
+func (f *Formatter) Duration(s float64) string {
+	return f.system().Duration.Format(s, f.Decimals)
+}
+
+// Default is the formatter used by the package level functions
+var Default = &Formatter{
+	System:   Astronomical,
+	Decimals: 2,
+}
+
+func Distance(m float64) string {
+	return Default.Distance(m)
+}
+
+func Speed(mps float64) string {
+	return Default.Speed(mps)
+}
+
+func Duration(s float64) string {
+	return Default.Duration(s)
+}
+
+// CycleSystem switches the default formatter to the next builtin system
+func CycleSystem() *System {
+	next := Systems[0]
+	for i, s := range Systems {
+		if s == Default.System {
+			next = Systems[(i+1)%len(Systems)]
+			break
+		}
+	}
+	Default.System = next
+	return next
+}
@@ -0,0 +1,144 @@
+package units
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDistance(t *testing.T) {
+	f := &Formatter{System: Astronomical, Decimals: 2}
+	tests := []struct {
+		m    float64
+		want string
+	}{
+		{0, "0.00 m"},
+		{-0.001, "0.00 m"},
+		{1.5, "1.50 m"},
+		{999.99, "999.99 m"},
+		{999.995, "1.00 km"},
+		{1000, "1.00 km"},
+		{-2500, "-2.50 km"},
+		{6.371e6, "6.37 Mm"},
+		{1.49e9, "1490.00 Mm"},
+		// rounds up into the next unit
+		{0.01*AstronomicalUnit - 1, "0.01 AU"},
+		{0.01 * AstronomicalUnit, "0.01 AU"},
+		{1.496e11, "1.00 AU"},
+		{0.1 * LightYear, "0.10 ly"},
+		{4.2 * LightYear, "4.20 ly"},
+		{math.Inf(1), "∞"},
+		{math.NaN(), "NaN"},
+	}
+	for _, tc := range tests {
+		if got := f.Distance(tc.m); got != tc.want {
+			t.Errorf("Distance(%v) = %q, want %q", tc.m, got, tc.want)
+		}
+	}
+}
+
+func TestSpeed(t *testing.T) {
+	f := &Formatter{System: Astronomical, Decimals: 2}
+	tests := []struct {
+		mps  float64
+		want string
+	}{
+		{0, "0.00 m/s"},
+		{12.345, "12.35 m/s"},
+		{999.999, "1.00 km/s"},
+		{2.97222e4, "29.72 km/s"},
+		{0.01*SpeedOfLight - 1, "2997.92 km/s"},
+		{0.01 * SpeedOfLight, "0.0100 c"},
+		{0.5 * SpeedOfLight, "0.5000 c"},
+		{-SpeedOfLight, "-1.0000 c"},
+	}
+	for _, tc := range tests {
+		if got := f.Speed(tc.mps); got != tc.want {
+			t.Errorf("Speed(%v) = %q, want %q", tc.mps, got, tc.want)
+		}
+	}
+}
+
+func TestDuration(t *testing.T) {
+	f := &Formatter{System: Astronomical, Decimals: 1}
+	tests := []struct {
+		s    float64
+		want string
+	}{
+		{0, "0.0 s"},
+		{59.94, "59.9 s"},
+		{59.96, "1.0 min"},
+		{90, "1.5 min"},
+		{3600, "1.0 h"},
+		{86400 * 1.5, "1.5 d"},
+		{365.25 * 86400, "1.0 yr"},
+		{365.2 * 86400, "365.2 d"},
+	}
+	for _, tc := range tests {
+		if got := f.Duration(tc.s); got != tc.want {
+			t.Errorf("Duration(%v) = %q, want %q", tc.s, got, tc.want)
+		}
+	}
+}
+
+func TestSystems(t *testing.T) {
+	tests := []struct {
+		sys  *System
+		m    float64
+		want string
+	}{
+		{Metric, 1.496e11, "149.60 Gm"},
+		{Metric, 999.999e9, "1.00 Tm"},
+		{Imperial, 1, "3.28 ft"},
+		{Imperial, 1609.344, "1.00 mi"},
+		{Imperial, 1.496e11, "1.00 AU"},
+	}
+	for _, tc := range tests {
+		f := &Formatter{System: tc.sys, Decimals: 2}
+		if got := f.Distance(tc.m); got != tc.want {
+			t.Errorf("%s Distance(%v) = %q, want %q", tc.sys.Name, tc.m, got, tc.want)
+		}
+	}
+}
+
+func TestParseSystem(t *testing.T) {
+	for _, s := range Systems {
+		if got := ParseSystem(s.Name); got != s {
+			t.Errorf("ParseSystem(%q) = %v, want %v", s.Name, got, s)
+		}
+	}
+	if got := ParseSystem("unknown"); got != nil {
+		t.Errorf("ParseSystem(unknown) = %v, want nil", got)
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		parse func(string) (float64, error)
+		s     string
+		want  float64
+		err   bool
+	}{
+		{ParseDistance, "12", 12, false},
+		{ParseDistance, "1.5km", 1500, false},
+		{ParseDistance, "-2 Mm", -2e6, false},
+		{ParseDistance, "1e3 km", 1e6, false},
+		{ParseDistance, "2 AU", 2 * AstronomicalUnit, false},
+		{ParseDistance, "1 mi", Mile, false},
+		{ParseDistance, "1 parsec", 0, true},
+		{ParseDistance, "km", 0, true},
+		{ParseSpeed, "3 km/s", 3000, false},
+		{ParseSpeed, "0.5c", 0.5 * SpeedOfLight, false},
+		{ParseDuration, "2h", 2 * Hour, false},
+		{ParseDuration, "1 yr", Year, false},
+	}
+	for _, tc := range tests {
+		got, err := tc.parse(tc.s)
+		if (err != nil) != tc.err {
+			t.Errorf("parse %q: error = %v, want error %v", tc.s, err, tc.err)
+			continue
+		}
+		if !tc.err && math.Abs(got-tc.want) > 1e-9*math.Abs(tc.want) {
+			t.Errorf("parse %q = %v, want %v", tc.s, got, tc.want)
+		}
+	}
+}
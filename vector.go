@@ -20,3 +20,45 @@ func ToG3NVec3(vec3 *mol.Vec3) *math32.Vector3 {
 		Z: (float32)(vec3.Z),
 	}
 }
+
+func vecDot(a, b mol.Vec3) float64 {
+	return a.X*b.X + a.Y*b.Y + a.Z*b.Z
+}
+
+func vecCross(a, b mol.Vec3) mol.Vec3 {
+	return mol.Vec3{
+		X: a.Y*b.Z - a.Z*b.Y,
+		Y: a.Z*b.X - a.X*b.Z,
+		Z: a.X*b.Y - a.Y*b.X,
+	}
+}
+
+func vecScaled(a mol.Vec3, n float64) mol.Vec3 {
+	a.ScaleN(n)
+	return a
+}
+
+func vecAdded(a, b mol.Vec3) mol.Vec3 {
+	a.Add(b)
+	return a
+}
+
+// vecNormalized returns the unit vector of a, or a zero vector if a is zero
+func vecNormalized(a mol.Vec3) mol.Vec3 {
+	l := a.Len()
+	if l == 0 {
+		return mol.Vec3{}
+	}
+	return vecScaled(a, 1/l)
+}
+
+// renderPos converts the physics position to the scaled scene position
+func renderPos(pos mol.Vec3) math32.Vector3 {
+	pos.ScaleN(1. / posScale)
+	return *ToG3NVec3(&pos)
+}
+
+// vecLerp returns the linear interpolation between a and b
+func vecLerp(a, b mol.Vec3, t float64) mol.Vec3 {
+	return vecAdded(a, vecScaled(b.Subbed(a), t))
+}
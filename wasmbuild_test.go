@@ -0,0 +1,31 @@
+package main
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+// TestBuildWasm builds the game and its tests for GOOS=js GOARCH=wasm.
+// It's a full cross build, so it only runs when CURVE_TEST_WASM=1.
+func TestBuildWasm(t *testing.T) {
+	if os.Getenv("CURVE_TEST_WASM") != "1" {
+		t.Skip("set CURVE_TEST_WASM=1 to build for wasm")
+	}
+	gobin, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go command not found:", err)
+	}
+	out := t.TempDir()
+	for _, args := range [][]string{
+		{"build", "-o", filepath.Join(out, "curve.wasm"), "."},
+		{"test", "-c", "-o", filepath.Join(out, "curve_test.wasm"), "."},
+	} {
+		cmd := exec.Command(gobin, args...)
+		cmd.Env = append(os.Environ(), "GOOS=js", "GOARCH=wasm", "CGO_ENABLED=0")
+		if output, err := cmd.CombinedOutput(); err != nil {
+			t.Errorf("go %v: %v\n%s", args, err, output)
+		}
+	}
+}